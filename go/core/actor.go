@@ -0,0 +1,208 @@
+package core
+
+import (
+	"dabble/eval"
+	"dabble/object"
+	"fmt"
+	"time"
+)
+
+// Self is bound to the running actor within its body.
+const Self = object.Symbol("self")
+
+// SpawnActor evaluates a form on a new goroutine as the body of an actor
+// and returns the actor. Within the form self is bound to the actor.
+func SpawnActor(env *eval.Frame, args ...object.Value) object.Value {
+	if err := argsLenError("spawn-actor", args, 1); err != nil {
+		return err
+	}
+	actor := object.NewActor()
+	actorEnv := env.Detached().Bind(Self, actor)
+	actor.Start(func() object.Value {
+		return eval.Eval(actorEnv, args[0])
+	})
+	return actor
+}
+
+// SendBang sends a message to an actor and returns the message.
+func SendBang(env *eval.Frame, args ...object.Value) object.Value {
+	if err := argsLenError("send!", args, 2); err != nil {
+		return err
+	}
+	actor, err := evalActor(env, "send!", args[0])
+	if err != nil {
+		return err
+	}
+	message := eval.Eval(env, args[1])
+	if message.Type() == object.ERROR {
+		return message
+	}
+	actor.Send(message)
+	return message
+}
+
+// Receive takes the oldest message in the mailbox of self which matches one
+// of its clauses, waiting if there is none. Each clause is (pattern form)
+// and form is evaluated with the variables of the pattern bound. A last
+// clause (after milliseconds form) is evaluated if no message matches in
+// time.
+//
+// In patterns _ matches anything, a symbol matches anything and binds it,
+// a list matches a list of the same shape, a quoted value matches an equal
+// value and an unquoted form matches the value it evaluates to. Other
+// values match themselves.
+func Receive(env *eval.Frame, args ...object.Value) object.Value {
+	if len(args) == 0 {
+		return object.Error("receive wants at least 1 clause")
+	}
+	self, err := evalActor(env, "receive", Self)
+	if err != nil {
+		return err
+	}
+	timeout := time.Duration(-1)
+	var timeoutForm object.Value
+	patterns := []object.Value{}
+	forms := []object.Value{}
+	for i, clause := range args {
+		parts := []object.Value{}
+		for c := clause; c.Type() == object.CELL; c = c.Rest() {
+			parts = append(parts, c.First())
+		}
+		if i == len(args)-1 && len(parts) == 3 && parts[0] == object.Symbol("after") {
+			ms := eval.Eval(env, parts[1])
+			if ms.Type() == object.ERROR {
+				return ms
+			}
+			if ms.Type() != object.NUMBER || ms.(object.Number) < 0 {
+				return object.Error(fmt.Sprintf("receive timeout must be a non-negative number: %v", ms))
+			}
+			timeout = time.Duration(ms.(object.Number)) * time.Millisecond
+			timeoutForm = parts[2]
+			continue
+		}
+		if len(parts) != 2 {
+			return object.Error(fmt.Sprintf("receive clause must be (pattern form): %v", clause))
+		}
+		pattern := pin(env, parts[0])
+		if pattern.Type() == object.ERROR {
+			return pattern
+		}
+		patterns = append(patterns, pattern)
+		forms = append(forms, parts[1])
+	}
+
+	var chosen int
+	var bindings *eval.Frame
+	message, ok := self.Receive(func(m object.Value) bool {
+		for i, p := range patterns {
+			if b, ok := match(p, m, env); ok {
+				chosen, bindings = i, b
+				return true
+			}
+		}
+		return false
+	}, timeout)
+	if !ok {
+		env.T("receive timed out after %v", timeout)
+		return eval.Eval(env, timeoutForm)
+	}
+	env.T("received %v matching %v", message, patterns[chosen])
+	return eval.Eval(bindings, forms[chosen])
+}
+
+// Link links self and another actor so that each is sent
+// (exit <actor> <message>) when the other fails with an error.
+func Link(env *eval.Frame, args ...object.Value) object.Value {
+	if err := argsLenError("link", args, 1); err != nil {
+		return err
+	}
+	self, err := evalActor(env, "link", Self)
+	if err != nil {
+		return err
+	}
+	other, err := evalActor(env, "link", args[0])
+	if err != nil {
+		return err
+	}
+	self.Link(other)
+	return other
+}
+
+// pin replaces the unquoted forms of a pattern with the quoted values they
+// evaluate to.
+func pin(env *eval.Frame, pattern object.Value) object.Value {
+	switch pattern.Type() {
+	case object.UNQUOTED:
+		value := eval.Eval(env, pattern.First())
+		if value.Type() == object.ERROR {
+			return value
+		}
+		return object.Quoted(value)
+	case object.CELL:
+		first := pin(env, pattern.First())
+		if first.Type() == object.ERROR {
+			return first
+		}
+		rest := pin(env, pattern.Rest())
+		if rest.Type() == object.ERROR {
+			return rest
+		}
+		return object.Cell(first, rest)
+	default:
+		return pattern
+	}
+}
+
+// match matches a value against a pinned pattern, returning env with the
+// pattern variables bound. A variable used twice must match equal values.
+func match(pattern, value object.Value, env *eval.Frame) (*eval.Frame, bool) {
+	b := &bindings{values: map[object.Symbol]object.Value{}}
+	if !b.match(pattern, value) {
+		return env, false
+	}
+	for _, s := range b.order {
+		env = env.Bind(s, b.values[s])
+	}
+	return env, true
+}
+
+type bindings struct {
+	order  []object.Symbol
+	values map[object.Symbol]object.Value
+}
+
+func (b *bindings) match(pattern, value object.Value) bool {
+	switch pattern.Type() {
+	case object.SYMBOL:
+		symbol := pattern.(object.Symbol)
+		if symbol == "_" {
+			return true
+		}
+		if bound, ok := b.values[symbol]; ok {
+			return object.Equal(bound, value)
+		}
+		b.order = append(b.order, symbol)
+		b.values[symbol] = value
+		return true
+	case object.QUOTED:
+		return object.Equal(pattern.First(), value)
+	case object.CELL:
+		if value.Type() != object.CELL {
+			return false
+		}
+		return b.match(pattern.First(), value.First()) && b.match(pattern.Rest(), value.Rest())
+	default:
+		return object.Equal(pattern, value)
+	}
+}
+
+func evalActor(env *eval.Frame, name string, arg object.Value) (*object.Actor, object.Value) {
+	actor := eval.Eval(env, arg)
+	if actor.Type() == object.ERROR {
+		return nil, actor
+	}
+	if actor.Type() != object.ACTOR {
+		return nil, object.Error(fmt.Sprintf("%v non-actor: %v", name, actor))
+	}
+	return actor.(*object.Actor), nil
+}
@@ -0,0 +1,117 @@
+package core
+
+import (
+	"dabble/object"
+	"strconv"
+	"testing"
+)
+
+func TestActor(t *testing.T) {
+
+	tests := []coreTest{{
+		input: "(label s (send! self 'hello) (receive (x x)))",
+		want:  "hello",
+	}, {
+		input: "(label s (send! self 'b) (label s (send! self 'a) (receive ('a 1) ('b 2))))",
+		want:  "2",
+	}, {
+		input: `
+(label s (send! self 'b)
+  (label s (send! self 'a)
+    (label first (receive ('a 'got-a))
+      (cons first (cons (receive (x x)) ())))))`,
+		want: "(got-a b)",
+	}, {
+		input: "(label s (send! self '(point 1 2)) (receive (('point x y) (cons y x))))",
+		want:  "(2 1)",
+	}, {
+		input: "(label s (send! self '(1 2)) (label s (send! self '(1 1)) (receive ((x x) x))))",
+		want:  "1",
+	}, {
+		input: "(label s (send! self '(a 1)) (label s (send! self '(b 2)) (label k 'b (receive ((`k v) v)))))",
+		want:  "2",
+	}, {
+		input: "(label s (send! self '(1 2 3)) (receive ((_ . rest) rest)))",
+		want:  "(2 3)",
+	}, {
+		input: "(receive (x x) (after 0 'timeout))",
+		want:  "timeout",
+	}, {
+		input: "(label s (send! self 'other) (receive ('wanted 1) (after 0 'timeout)))",
+		want:  "timeout",
+	}, {
+		input: `
+(label parent self
+  (label echo (spawn-actor (receive ((from msg) (send! from (cons 'echo msg)))))
+    (label s (send! echo (cons self '(hi)))
+      (receive (x x)))))`,
+		want: "(echo hi)",
+	}, {
+		input: `
+(label counter
+  (spawn-actor
+    ((lambda (n)
+       (receive
+         ('inc (recur (cons 1 n)))
+         (('get from) (send! from n))))
+     ()))
+  (label s (send! counter 'inc)
+    (label s (send! counter 'inc)
+      (label s (send! counter (cons 'get (cons self ())))
+        (receive (n n))))))`,
+		want: "(1 1)",
+	}, {
+		input: `
+(label parent self
+  (label child (spawn-actor (label l (link parent) (label m (receive ('go ())) (error boom))))
+    (label s (send! child 'go)
+      (receive (('exit a reason) (cons (eq a child) (cons reason ())))))))`,
+		want: "(t boom)",
+	}, {
+		input: `
+(label child (spawn-actor (car '(done)))
+  (await child))`,
+		want: "done",
+	}, {
+		// Matching forces the tail of the stream, which sends to self.
+		input: `
+(label s (send! self (cons-stream 1 (cons (send! self 2) ())))
+  (label r (receive ('(1 2) 'matched))
+    (cons r (cons (receive (x x)) ()))))`,
+		want: "(matched 2)",
+	}, {
+		input:   "(label s (send! self 1) (receive (x (error oops))))",
+		wantErr: true,
+	}, {
+		input:   "(receive)",
+		wantErr: true,
+	}, {
+		input:   "(receive (x))",
+		wantErr: true,
+	}, {
+		input:   "(receive (x x) (after -1 ()))",
+		wantErr: true,
+	}, {
+		input:   "(send! 1 2)",
+		wantErr: true,
+	}, {
+		input:   "(link 1)",
+		wantErr: true,
+	}}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			testCore(t, Env.Bind(Self, object.NewActor()), []coreTest{tt})
+		})
+	}
+}
+
+func TestActorOutsideActor(t *testing.T) {
+	testCore(t, Env, []coreTest{{
+		input:   "(receive (x x))",
+		wantErr: true,
+	}, {
+		input:   "(link (spawn-actor 1))",
+		wantErr: true,
+	}})
+}
@@ -0,0 +1,160 @@
+package core
+
+import (
+	"dabble/eval"
+	"dabble/object"
+	"fmt"
+)
+
+// Arithmetic follows the numeric tower in the object package: integers,
+// then ratios, then floats. Integers too large for a Number are promoted
+// to a BigInt, and a float result which is infinite or NaN is an error.
+
+// Add sums its arguments. (+) is 0.
+func Add(env *eval.Frame, args ...object.Value) object.Value {
+	numbers, err := evalNumbers(env, "+", args)
+	if err != nil {
+		return err
+	}
+	sum := object.Value(object.Number(0))
+	for _, n := range numbers {
+		sum = object.Add(sum, n)
+	}
+	return finite(sum)
+}
+
+// Sub subtracts the rest of its arguments from the first. (- n) negates n.
+func Sub(env *eval.Frame, args ...object.Value) object.Value {
+	if len(args) == 0 {
+		return object.Error("- wants at least 1 arg(s). got 0")
+	}
+	numbers, err := evalNumbers(env, "-", args)
+	if err != nil {
+		return err
+	}
+	if len(numbers) == 1 {
+		return finite(object.Sub(object.Number(0), numbers[0]))
+	}
+	diff := numbers[0]
+	for _, n := range numbers[1:] {
+		diff = object.Sub(diff, n)
+	}
+	return finite(diff)
+}
+
+// Mul multiplies its arguments. (*) is 1.
+func Mul(env *eval.Frame, args ...object.Value) object.Value {
+	numbers, err := evalNumbers(env, "*", args)
+	if err != nil {
+		return err
+	}
+	product := object.Value(object.Number(1))
+	for _, n := range numbers {
+		product = object.Mul(product, n)
+	}
+	return finite(product)
+}
+
+// Div divides the first argument by the second. Dividing exact numbers
+// gives an exact result, so (/ 1 3) is 1/3.
+func Div(env *eval.Frame, args ...object.Value) object.Value {
+	if err := argsLenError("/", args, 2); err != nil {
+		return err
+	}
+	numbers, err := evalNumbers(env, "/", args)
+	if err != nil {
+		return err
+	}
+	quo, ok := object.Quo(numbers[0], numbers[1])
+	if !ok {
+		return object.Error(fmt.Sprintf("/ division by zero: %v", numbers[0]))
+	}
+	return finite(quo)
+}
+
+// Quotient divides the first integer by the second, truncating towards
+// zero.
+func Quotient(env *eval.Frame, args ...object.Value) object.Value {
+	if err := argsLenError("quotient", args, 2); err != nil {
+		return err
+	}
+	numbers, err := evalIntegers(env, "quotient", args)
+	if err != nil {
+		return err
+	}
+	quo, ok := object.Quotient(numbers[0], numbers[1])
+	if !ok {
+		return object.Error(fmt.Sprintf("quotient division by zero: %v", numbers[0]))
+	}
+	return quo
+}
+
+// Mod returns the first integer modulo the second. The result has the sign
+// of the second.
+func Mod(env *eval.Frame, args ...object.Value) object.Value {
+	if err := argsLenError("mod", args, 2); err != nil {
+		return err
+	}
+	numbers, err := evalIntegers(env, "mod", args)
+	if err != nil {
+		return err
+	}
+	mod, ok := object.Mod(numbers[0], numbers[1])
+	if !ok {
+		return object.Error(fmt.Sprintf("mod division by zero: %v", numbers[0]))
+	}
+	return mod
+}
+
+// Less returns t if its arguments are in strictly increasing order.
+func Less(env *eval.Frame, args ...object.Value) object.Value {
+	if len(args) == 0 {
+		return object.Error("< wants at least 1 arg(s). got 0")
+	}
+	numbers, err := evalNumbers(env, "<", args)
+	if err != nil {
+		return err
+	}
+	for i := 1; i < len(numbers); i++ {
+		if object.Cmp(numbers[i-1], numbers[i]) >= 0 {
+			return object.Nil
+		}
+	}
+	return object.Symbol("t")
+}
+
+func evalNumbers(env *eval.Frame, name string, args []object.Value) ([]object.Value, object.Value) {
+	numbers := make([]object.Value, len(args))
+	for i, a := range args {
+		n := eval.Eval(env, a)
+		if n.Type() == object.ERROR {
+			return nil, n
+		}
+		if !object.IsNumber(n) {
+			return nil, object.Error(fmt.Sprintf("%v non-number: %v", name, n))
+		}
+		numbers[i] = n
+	}
+	return numbers, nil
+}
+
+func evalIntegers(env *eval.Frame, name string, args []object.Value) ([]object.Value, object.Value) {
+	numbers, err := evalNumbers(env, name, args)
+	if err != nil {
+		return nil, err
+	}
+	for _, n := range numbers {
+		if !object.IsInteger(n) {
+			return nil, object.Error(fmt.Sprintf("%v non-integer: %v", name, n))
+		}
+	}
+	return numbers, nil
+}
+
+// finite returns n, or an error if n is an infinite or NaN float.
+func finite(n object.Value) object.Value {
+	if f, ok := n.(object.Float); ok && !f.IsFinite() {
+		return object.Error(fmt.Sprintf("float overflow: %v", f))
+	}
+	return n
+}
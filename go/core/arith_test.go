@@ -0,0 +1,204 @@
+package core
+
+import (
+	"testing"
+)
+
+func TestArith(t *testing.T) {
+
+	tests := []coreTest{{
+		input: "(+)",
+		want:  "0",
+	}, {
+		input: "(+ 1 2 3)",
+		want:  "6",
+	}, {
+		input: "(+ -1 -2)",
+		want:  "-3",
+	}, {
+		input: "(+ 9223372036854775807 1)",
+		want:  "9223372036854775808",
+	}, {
+		input: "(+ -9223372036854775808 -1)",
+		want:  "-9223372036854775809",
+	}, {
+		input:   "(+ 1 'a)",
+		wantErr: true,
+	}, {
+		input: "(- 5)",
+		want:  "-5",
+	}, {
+		input: "(- 10 3 2)",
+		want:  "5",
+	}, {
+		input: "(- -9223372036854775808)",
+		want:  "9223372036854775808",
+	}, {
+		input:   "(-)",
+		wantErr: true,
+	}, {
+		input: "(*)",
+		want:  "1",
+	}, {
+		input: "(* 2 -3 4)",
+		want:  "-24",
+	}, {
+		input: "(* 4294967296 4294967296)",
+		want:  "18446744073709551616",
+	}, {
+		input: "(* -1 -9223372036854775808)",
+		want:  "9223372036854775808",
+	}, {
+		input: "(/ 7 2)",
+		want:  "7/2",
+	}, {
+		input: "(/ -7 2)",
+		want:  "-7/2",
+	}, {
+		input: "(/ 6 3)",
+		want:  "2",
+	}, {
+		input: "(quotient 7 2)",
+		want:  "3",
+	}, {
+		input: "(quotient -7 2)",
+		want:  "-3",
+	}, {
+		input:   "(quotient 7 0)",
+		wantErr: true,
+	}, {
+		input:   "(quotient 7/2 2)",
+		wantErr: true,
+	}, {
+		input:   "(mod 1.5 1)",
+		wantErr: true,
+	}, {
+		input:   "(/ 1 0)",
+		wantErr: true,
+	}, {
+		input: "(/ -9223372036854775808 -1)",
+		want:  "9223372036854775808",
+	}, {
+		input: "(mod 7 3)",
+		want:  "1",
+	}, {
+		input: "(mod -7 3)",
+		want:  "2",
+	}, {
+		input: "(mod 7 -3)",
+		want:  "-2",
+	}, {
+		input:   "(mod 1 0)",
+		wantErr: true,
+	}, {
+		input: "(< 1 2)",
+		want:  "t",
+	}, {
+		input: "(< 2 1)",
+		want:  "()",
+	}, {
+		input: "(< -3 -2 0 7)",
+		want:  "t",
+	}, {
+		input: "(< 1 1)",
+		want:  "()",
+	}, {
+		input:   "(< 1 'a)",
+		wantErr: true,
+	}, {
+		input: "((lambda (n) (if (< n 1) 1 (* n (recur (- n 1))))) 20)",
+		want:  "2432902008176640000",
+	}, {
+		input: "((lambda (n) (if (< n 1) 1 (* n (recur (- n 1))))) 30)",
+		want:  "265252859812191058636308480000000",
+	}, {
+		input: "(/ ((lambda (n) (if (< n 1) 1 (* n (recur (- n 1))))) 30) ((lambda (n) (if (< n 1) 1 (* n (recur (- n 1))))) 29))",
+		want:  "30",
+	}, {
+		input: "(- 100000000000000000000 99999999999999999999)",
+		want:  "1",
+	}, {
+		input: "(mod -100000000000000000000 7)",
+		want:  "5",
+	}, {
+		input: "(mod 100000000000000000000 -7)",
+		want:  "-5",
+	}, {
+		input: "(< 1 100000000000000000000 200000000000000000000)",
+		want:  "t",
+	}, {
+		input: "(< -100000000000000000000 -9223372036854775808)",
+		want:  "t",
+	}, {
+		input: "(eq 100000000000000000000 (* 10000000000 10000000000))",
+		want:  "t",
+	}, {
+		input: "(eq 9223372036854775807 (- 9223372036854775808 1))",
+		want:  "t",
+	}, {
+		input:   "(/ 100000000000000000000 0)",
+		wantErr: true,
+	}, {
+		input: "(+ 1/2 1/3)",
+		want:  "5/6",
+	}, {
+		input: "(+ 1/2 1/2)",
+		want:  "1",
+	}, {
+		input: "(* 2/3 3)",
+		want:  "2",
+	}, {
+		input: "(- 1/2)",
+		want:  "-1/2",
+	}, {
+		input: "(/ 1/2 1/4)",
+		want:  "2",
+	}, {
+		input: "(+ 1 100000000000000000000/3)",
+		want:  "100000000000000000003/3",
+	}, {
+		input: "(+ 1.5 1)",
+		want:  "2.5",
+	}, {
+		input: "(+ 1/2 0.25)",
+		want:  "0.75",
+	}, {
+		input: "(* 2.0 3)",
+		want:  "6.0",
+	}, {
+		input: "(/ 1.0 4)",
+		want:  "0.25",
+	}, {
+		input:   "(/ 1.0 0)",
+		wantErr: true,
+	}, {
+		input:   "(/ 1 0.0)",
+		wantErr: true,
+	}, {
+		input:   "(* 1e300 1e300)",
+		wantErr: true,
+	}, {
+		input: "(< 1/3 0.34 1/2 1 1.5)",
+		want:  "t",
+	}, {
+		input: "(< 1/2 0.5)",
+		want:  "()",
+	}, {
+		input: "(< 9007199254740992.0 9007199254740993 9007199254740994.0)",
+		want:  "t",
+	}, {
+		input: "(eq 1/2 2/4)",
+		want:  "t",
+	}, {
+		input: "(eq 0.5 0.5)",
+		want:  "t",
+	}, {
+		input: "(eq 1/2 0.5)",
+		want:  "()",
+	}, {
+		input: "(eq 1 1.0)",
+		want:  "()",
+	}}
+
+	testCore(t, Env, tests)
+}
@@ -0,0 +1,162 @@
+package core
+
+import (
+	"dabble/eval"
+	"dabble/object"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// BytesLength returns the number of bytes in a byte array.
+func BytesLength(env *eval.Frame, args ...object.Value) object.Value {
+	if err := argsLenError("bytes-length", args, 1); err != nil {
+		return err
+	}
+	b, err := evalBytes(env, "bytes-length", args[0])
+	if err != nil {
+		return err
+	}
+	return object.Number(len(b))
+}
+
+// BytesRef returns the byte at an index as a number.
+func BytesRef(env *eval.Frame, args ...object.Value) object.Value {
+	if err := argsLenError("bytes-ref", args, 2); err != nil {
+		return err
+	}
+	b, err := evalBytes(env, "bytes-ref", args[0])
+	if err != nil {
+		return err
+	}
+	n := eval.Eval(env, args[1])
+	if n.Type() == object.ERROR {
+		return n
+	}
+	if n.Type() != object.NUMBER {
+		return object.Error(fmt.Sprintf("bytes-ref non-number index: %v", n))
+	}
+	i := n.(object.Number)
+	if i < 0 || int64(i) >= int64(len(b)) {
+		return object.Error(fmt.Sprintf("bytes-ref index %v out of range for %v", i, b))
+	}
+	return object.Number(b[i])
+}
+
+// BytesSlice returns the bytes from start up to end. (bytes-slice b start)
+// runs to the end of b.
+func BytesSlice(env *eval.Frame, args ...object.Value) object.Value {
+	if len(args) != 2 && len(args) != 3 {
+		return object.Error(fmt.Sprintf("bytes-slice wants 2 or 3 arg(s). got %v", len(args)))
+	}
+	b, err := evalBytes(env, "bytes-slice", args[0])
+	if err != nil {
+		return err
+	}
+	bounds := []int{0, len(b)}
+	for i, a := range args[1:] {
+		n := eval.Eval(env, a)
+		if n.Type() == object.ERROR {
+			return n
+		}
+		if n.Type() != object.NUMBER {
+			return object.Error(fmt.Sprintf("bytes-slice non-number index: %v", n))
+		}
+		bounds[i] = int(n.(object.Number))
+	}
+	start, end := bounds[0], bounds[1]
+	if start < 0 || end > len(b) || start > end {
+		return object.Error(fmt.Sprintf("bytes-slice %v to %v out of range for %v", start, end, b))
+	}
+	return b[start:end]
+}
+
+// BytesAppend concatenates any number of byte arrays.
+func BytesAppend(env *eval.Frame, args ...object.Value) object.Value {
+	var s strings.Builder
+	for _, a := range args {
+		b, err := evalBytes(env, "bytes-append", a)
+		if err != nil {
+			return err
+		}
+		s.WriteString(string(b))
+	}
+	return object.Bytes(s.String())
+}
+
+// StringToBytes returns the UTF-8 encoding of a string.
+func StringToBytes(env *eval.Frame, args ...object.Value) object.Value {
+	if err := argsLenError("string->bytes", args, 1); err != nil {
+		return err
+	}
+	s, err := evalString(env, "string->bytes", args[0])
+	if err != nil {
+		return err
+	}
+	return object.Bytes(s)
+}
+
+// BytesToString decodes a byte array as UTF-8.
+func BytesToString(env *eval.Frame, args ...object.Value) object.Value {
+	if err := argsLenError("bytes->string", args, 1); err != nil {
+		return err
+	}
+	b, err := evalBytes(env, "bytes->string", args[0])
+	if err != nil {
+		return err
+	}
+	if !utf8.ValidString(string(b)) {
+		return object.Error(fmt.Sprintf("bytes->string invalid UTF-8: %v", b))
+	}
+	return object.String(b)
+}
+
+// ListToBytes returns a byte array of a list of numbers from 0 to 255.
+func ListToBytes(env *eval.Frame, args ...object.Value) object.Value {
+	if err := argsLenError("list->bytes", args, 1); err != nil {
+		return err
+	}
+	list := eval.Eval(env, args[0])
+	if list.Type() == object.ERROR {
+		return list
+	}
+	var b []byte
+	for ; list.Type() == object.CELL; list = list.Rest() {
+		n, ok := list.First().(object.Number)
+		if !ok || n < 0 || n > 255 {
+			return object.Error(fmt.Sprintf("list->bytes invalid byte: %v", list.First()))
+		}
+		b = append(b, byte(n))
+	}
+	if list.Type() != object.NIL {
+		return object.Error(fmt.Sprintf("list->bytes improper list: %v", args[0]))
+	}
+	return object.Bytes(b)
+}
+
+// BytesToList returns a list of the bytes of a byte array as numbers.
+func BytesToList(env *eval.Frame, args ...object.Value) object.Value {
+	if err := argsLenError("bytes->list", args, 1); err != nil {
+		return err
+	}
+	b, err := evalBytes(env, "bytes->list", args[0])
+	if err != nil {
+		return err
+	}
+	list := object.Value(object.Nil)
+	for i := len(b) - 1; i >= 0; i-- {
+		list = object.Cell(object.Number(b[i]), list)
+	}
+	return list
+}
+
+func evalBytes(env *eval.Frame, name string, arg object.Value) (object.Bytes, object.Value) {
+	b := eval.Eval(env, arg)
+	if b.Type() == object.ERROR {
+		return "", b
+	}
+	if b.Type() != object.BYTES {
+		return "", object.Error(fmt.Sprintf("%v non-bytes: %v", name, b))
+	}
+	return b.(object.Bytes), nil
+}
@@ -0,0 +1,72 @@
+package core
+
+import (
+	"testing"
+)
+
+func TestBytes(t *testing.T) {
+
+	tests := []coreTest{{
+		input: "#u8(1 2 3)",
+		want:  "#u8(1 2 3)",
+	}, {
+		input: "(bytes-length #u8(1 2 3))",
+		want:  "3",
+	}, {
+		input: "(bytes-ref #u8(1 2 255) 2)",
+		want:  "255",
+	}, {
+		input:   "(bytes-ref #u8(1 2 3) 3)",
+		wantErr: true,
+	}, {
+		input:   "(bytes-ref '(1 2 3) 0)",
+		wantErr: true,
+	}, {
+		input: "(bytes-slice #u8(1 2 3 4) 1 3)",
+		want:  "#u8(2 3)",
+	}, {
+		input: "(bytes-slice #u8(1 2 3 4) 2)",
+		want:  "#u8(3 4)",
+	}, {
+		input:   "(bytes-slice #u8(1 2 3 4) 3 2)",
+		wantErr: true,
+	}, {
+		input: "(bytes-append #u8(1) #u8() #u8(2 3))",
+		want:  "#u8(1 2 3)",
+	}, {
+		input: "(bytes-append)",
+		want:  "#u8()",
+	}, {
+		input: `(string->bytes "hé")`,
+		want:  "#u8(104 195 169)",
+	}, {
+		input: "(bytes->string #u8(104 195 169))",
+		want:  `"hé"`,
+	}, {
+		input:   "(bytes->string #u8(195))",
+		wantErr: true,
+	}, {
+		input: "(list->bytes '(0 127 255))",
+		want:  "#u8(0 127 255)",
+	}, {
+		input:   "(list->bytes '(256))",
+		wantErr: true,
+	}, {
+		input:   "(list->bytes '(a))",
+		wantErr: true,
+	}, {
+		input: "(bytes->list #u8(0 127 255))",
+		want:  "(0 127 255)",
+	}, {
+		input: "(car #u8(7 8))",
+		want:  "7",
+	}, {
+		input: "(eq #u8(1 2) (list->bytes '(1 2)))",
+		want:  "t",
+	}, {
+		input: "(get {#u8(1) one} (bytes-slice #u8(0 1) 1))",
+		want:  "one",
+	}}
+
+	testCore(t, Env, tests)
+}
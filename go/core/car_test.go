@@ -18,6 +18,12 @@ func TestCar(t *testing.T) {
 	}, {
 		input: "(car ())",
 		want:  "()",
+	}, {
+		input: "(car 'é)",
+		want:  `#\é`,
+	}, {
+		input: "(car 'λx)",
+		want:  `#\λ`,
 	}}
 
 	testCore(t, Env, tests)
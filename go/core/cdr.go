@@ -5,6 +5,8 @@ import (
 	"dabble/object"
 )
 
+// Cdr returns the rest of a value. A promise in the rest, such as the tail
+// of a stream, is forced so that a stream ends with () like a list.
 func Cdr(env *eval.Frame, args ...object.Value) object.Value {
 	if err := argsLenError("cdr", args, 1); err != nil {
 		return err
@@ -13,5 +15,9 @@ func Cdr(env *eval.Frame, args ...object.Value) object.Value {
 	if value.Type() == object.ERROR {
 		return value
 	}
-	return value.Rest()
+	rest := value.Rest()
+	if rest.Type() == object.PROMISE {
+		return rest.(*object.Promise).Force()
+	}
+	return rest
 }
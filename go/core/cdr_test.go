@@ -12,6 +12,12 @@ func TestCdr(t *testing.T) {
 	}, {
 		input: "(cdr '(1 2 3 4))",
 		want:  "(2 3 4)",
+	}, {
+		input: "(cdr 'λx)",
+		want:  "x",
+	}, {
+		input: "(cdr '日本語)",
+		want:  "本語",
 	}}
 
 	testCore(t, Env, tests)
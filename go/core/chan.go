@@ -0,0 +1,102 @@
+package core
+
+import (
+	"dabble/eval"
+	"dabble/object"
+	"fmt"
+	"reflect"
+)
+
+// Chan makes a channel. (chan) is unbuffered and (chan n) buffers n values.
+func Chan(env *eval.Frame, args ...object.Value) object.Value {
+	if len(args) > 1 {
+		return object.Error(fmt.Sprintf("chan wants 0 or 1 arg(s). got %v", len(args)))
+	}
+	size := 0
+	if len(args) == 1 {
+		n := eval.Eval(env, args[0])
+		if n.Type() == object.ERROR {
+			return n
+		}
+		if n.Type() != object.NUMBER || n.(object.Number) < 0 {
+			return object.Error(fmt.Sprintf("chan size must be a non-negative number: %v", n))
+		}
+		size = int(n.(object.Number))
+	}
+	return object.NewChannel(size)
+}
+
+// Send blocks until a value is sent on a channel and returns the value.
+func Send(env *eval.Frame, args ...object.Value) object.Value {
+	if err := argsLenError("send", args, 2); err != nil {
+		return err
+	}
+	ch, err := evalChannel(env, "send", args[0])
+	if err != nil {
+		return err
+	}
+	value := eval.Eval(env, args[1])
+	if value.Type() == object.ERROR {
+		return value
+	}
+	ch <- value
+	return value
+}
+
+// Recv blocks until a value is received from a channel.
+func Recv(env *eval.Frame, args ...object.Value) object.Value {
+	if err := argsLenError("recv", args, 1); err != nil {
+		return err
+	}
+	ch, err := evalChannel(env, "recv", args[0])
+	if err != nil {
+		return err
+	}
+	return <-ch
+}
+
+// Select receives from whichever channel is ready first. Each clause is
+// (channel symbol form). The received value is bound to symbol while form
+// of the chosen clause is evaluated.
+func Select(env *eval.Frame, args ...object.Value) object.Value {
+	if len(args) == 0 {
+		return object.Error("select wants at least 1 clause")
+	}
+	symbols := make([]object.Symbol, len(args))
+	forms := make([]object.Value, len(args))
+	cases := make([]reflect.SelectCase, len(args))
+	for i, clause := range args {
+		parts := []object.Value{}
+		for ; clause.Type() == object.CELL; clause = clause.Rest() {
+			parts = append(parts, clause.First())
+		}
+		if len(parts) != 3 || parts[1].Type() != object.SYMBOL {
+			return object.Error(fmt.Sprintf("select clause must be (channel symbol form): %v", args[i]))
+		}
+		ch, err := evalChannel(env, "select", parts[0])
+		if err != nil {
+			return err
+		}
+		symbols[i] = parts[1].(object.Symbol)
+		forms[i] = parts[2]
+		cases[i] = reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ch)}
+	}
+	chosen, received, ok := reflect.Select(cases)
+	var value object.Value = object.Nil
+	if ok {
+		value = received.Interface().(object.Value)
+	}
+	env.T("select received %v from clause %v", value, chosen)
+	return eval.Eval(env.Bind(symbols[chosen], value), forms[chosen])
+}
+
+func evalChannel(env *eval.Frame, name string, arg object.Value) (object.Channel, object.Value) {
+	ch := eval.Eval(env, arg)
+	if ch.Type() == object.ERROR {
+		return nil, ch
+	}
+	if ch.Type() != object.CHANNEL {
+		return nil, object.Error(fmt.Sprintf("%v non-channel: %v", name, ch))
+	}
+	return ch.(object.Channel), nil
+}
@@ -0,0 +1,63 @@
+package core
+
+import (
+	"testing"
+)
+
+func TestChan(t *testing.T) {
+
+	tests := []coreTest{{
+		input: "(chan)",
+		want:  "<channel 0/0>",
+	}, {
+		input: "(label c (chan 2) (label s (send c 1) c))",
+		want:  "<channel 1/2>",
+	}, {
+		input: "(label c (chan 1) (label s (send c '(1 2)) (recv c)))",
+		want:  "(1 2)",
+	}, {
+		input: "(label c (chan) (label f (spawn (lambda () (send c 'hello))) (recv c)))",
+		want:  "hello",
+	}, {
+		input: `
+(label c (chan)
+  (label f (spawn (lambda () (cons (recv c) (cons (recv c) ()))))
+    (label s (send c 1)
+      (label s (send c 2)
+        (await f)))))`,
+		want: "(1 2)",
+	}, {
+		input: `
+(label a (chan)
+  (label b (chan 1)
+    (label s (send b 'bee)
+      (select (a x (cons 'a x)) (b x (cons 'b x))))))`,
+		want: "(b bee)",
+	}, {
+		input: `
+(label a (chan)
+  (label f (spawn (lambda () (send a 'ay)))
+    (select (a x (cons 'a x)))))`,
+		want: "(a ay)",
+	}, {
+		input:   "(chan -1)",
+		wantErr: true,
+	}, {
+		input:   "(send 1 2)",
+		wantErr: true,
+	}, {
+		input:   "(recv 1)",
+		wantErr: true,
+	}, {
+		input:   "(select)",
+		wantErr: true,
+	}, {
+		input:   "(select ((chan) 1 x))",
+		wantErr: true,
+	}, {
+		input:   "(label c (chan 1) (send c (error oops)))",
+		wantErr: true,
+	}}
+
+	testCore(t, Env, tests)
+}
@@ -0,0 +1,106 @@
+package core
+
+import (
+	"dabble/eval"
+	"dabble/object"
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+)
+
+// CharToInteger returns the code point of a character.
+func CharToInteger(env *eval.Frame, args ...object.Value) object.Value {
+	if err := argsLenError("char->integer", args, 1); err != nil {
+		return err
+	}
+	c, err := evalChar(env, "char->integer", args[0])
+	if err != nil {
+		return err
+	}
+	return object.Number(c)
+}
+
+// IntegerToChar returns the character with a code point.
+func IntegerToChar(env *eval.Frame, args ...object.Value) object.Value {
+	if err := argsLenError("integer->char", args, 1); err != nil {
+		return err
+	}
+	n := eval.Eval(env, args[0])
+	if n.Type() == object.ERROR {
+		return n
+	}
+	if n.Type() != object.NUMBER {
+		return object.Error(fmt.Sprintf("integer->char non-number: %v", n))
+	}
+	if i := n.(object.Number); i < 0 || i > utf8.MaxRune || !utf8.ValidRune(rune(i)) {
+		return object.Error(fmt.Sprintf("integer->char invalid code point: %v", n))
+	}
+	return object.Char(n.(object.Number))
+}
+
+// CharToSymbol returns the symbol of one character.
+func CharToSymbol(env *eval.Frame, args ...object.Value) object.Value {
+	if err := argsLenError("char->symbol", args, 1); err != nil {
+		return err
+	}
+	c, err := evalChar(env, "char->symbol", args[0])
+	if err != nil {
+		return err
+	}
+	return object.Symbol(string(c))
+}
+
+// SymbolToChar returns the character of a symbol one character long.
+func SymbolToChar(env *eval.Frame, args ...object.Value) object.Value {
+	if err := argsLenError("symbol->char", args, 1); err != nil {
+		return err
+	}
+	s := eval.Eval(env, args[0])
+	if s.Type() == object.ERROR {
+		return s
+	}
+	if s.Type() != object.SYMBOL || utf8.RuneCountInString(string(s.(object.Symbol))) != 1 {
+		return object.Error(fmt.Sprintf("symbol->char wants a one character symbol: %v", s))
+	}
+	return s.First()
+}
+
+// CharLetter returns t if a character is a letter.
+func CharLetter(env *eval.Frame, args ...object.Value) object.Value {
+	return charIs(env, "char-letter?", unicode.IsLetter, args)
+}
+
+// CharDigit returns t if a character is a decimal digit.
+func CharDigit(env *eval.Frame, args ...object.Value) object.Value {
+	return charIs(env, "char-digit?", unicode.IsDigit, args)
+}
+
+// CharWhitespace returns t if a character is white space.
+func CharWhitespace(env *eval.Frame, args ...object.Value) object.Value {
+	return charIs(env, "char-whitespace?", unicode.IsSpace, args)
+}
+
+func charIs(env *eval.Frame, name string, is func(rune) bool, args []object.Value) object.Value {
+	if err := argsLenError(name, args, 1); err != nil {
+		return err
+	}
+	c, err := evalChar(env, name, args[0])
+	if err != nil {
+		return err
+	}
+	if is(rune(c)) {
+		return object.Symbol("t")
+	}
+	return object.Nil
+}
+
+func evalChar(env *eval.Frame, name string, arg object.Value) (object.Char, object.Value) {
+	c := eval.Eval(env, arg)
+	if c.Type() == object.ERROR {
+		return 0, c
+	}
+	if c.Type() != object.CHAR {
+		return 0, object.Error(fmt.Sprintf("%v non-character: %v", name, c))
+	}
+	return c.(object.Char), nil
+}
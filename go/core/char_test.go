@@ -0,0 +1,75 @@
+package core
+
+import (
+	"testing"
+)
+
+func TestChar(t *testing.T) {
+
+	tests := []coreTest{{
+		input: `#\a`,
+		want:  `#\a`,
+	}, {
+		input: `(car 'abc)`,
+		want:  `#\a`,
+	}, {
+		input: `(eq (car 'abc) #\a)`,
+		want:  "t",
+	}, {
+		input: `(char->integer #\a)`,
+		want:  "97",
+	}, {
+		input: `(char->integer #\λ)`,
+		want:  "955",
+	}, {
+		input: `(integer->char 233)`,
+		want:  `#\é`,
+	}, {
+		input: `(integer->char 32)`,
+		want:  `#\space`,
+	}, {
+		input:   `(integer->char -1)`,
+		wantErr: true,
+	}, {
+		input:   `(integer->char 55296)`,
+		wantErr: true,
+	}, {
+		input:   `(char->integer 'a)`,
+		wantErr: true,
+	}, {
+		input: `(char->symbol #\λ)`,
+		want:  "λ",
+	}, {
+		input: `(symbol->char 'λ)`,
+		want:  `#\λ`,
+	}, {
+		input:   `(symbol->char 'ab)`,
+		wantErr: true,
+	}, {
+		input: `(char-letter? #\é)`,
+		want:  "t",
+	}, {
+		input: `(char-letter? #\1)`,
+		want:  "()",
+	}, {
+		input: `(char-digit? #\7)`,
+		want:  "t",
+	}, {
+		input: `(char-digit? #\x)`,
+		want:  "()",
+	}, {
+		input: `(char-whitespace? #\tab)`,
+		want:  "t",
+	}, {
+		input: `(char-whitespace? #\u00a0)`,
+		want:  "t",
+	}, {
+		input: `(char-whitespace? #\a)`,
+		want:  "()",
+	}, {
+		input:   `(char-letter? "a")`,
+		wantErr: true,
+	}}
+
+	testCore(t, Env, tests)
+}
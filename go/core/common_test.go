@@ -25,9 +25,9 @@ func testCore(t *testing.T, env *eval.Frame, tests []coreTest) {
 			if err != nil {
 				t.Fatalf(err.Error())
 			}
-			eval.BeginTrace()
-			got := eval.Eval(env, value)
-			trace := eval.EndTrace()
+			traced := env.Traced()
+			got := eval.Eval(traced, value)
+			trace := traced.Trace()
 			var printTrace bool
 			if tt.wantErr {
 				if _, ok := got.(object.Error); !ok {
@@ -0,0 +1,119 @@
+package core
+
+import (
+	"dabble/eval"
+	"dabble/object"
+	"fmt"
+	"strings"
+)
+
+// Defrecord defines a record type and evaluates a form with its functions
+// bound. (defrecord point (x y) form) binds:
+//
+//	(make-point x y)     a constructor
+//	(point-x p)          an accessor for each field
+//	(point? v)           a predicate
+//	(point-with-x p x)   an updater for each field, returning a new record
+func Defrecord(env *eval.Frame, args ...object.Value) object.Value {
+	if err := argsLenError("defrecord", args, 3); err != nil {
+		return err
+	}
+	if args[0].Type() != object.SYMBOL {
+		return object.Error(fmt.Sprintf("defrecord non-symbol name: %v", args[0]))
+	}
+	name := string(args[0].(object.Symbol))
+	if t := object.Type(strings.ToUpper(name)); object.IsBuiltinType(t) {
+		return object.Error(fmt.Sprintf("defrecord name of a builtin type: %v", name))
+	}
+	if _, ok := object.LookupType(object.Type(name)); ok {
+		return object.Error(fmt.Sprintf("defrecord name of a registered type: %v", name))
+	}
+	var fields []string
+	for list := args[1]; list.Type() != object.NIL; list = list.Rest() {
+		if list.Type() != object.CELL || list.First().Type() != object.SYMBOL {
+			return object.Error(fmt.Sprintf("defrecord fields must be a list of symbols: %v", args[1]))
+		}
+		field := string(list.First().(object.Symbol))
+		for _, f := range fields {
+			if f == field {
+				return object.Error(fmt.Sprintf("defrecord duplicate field: %v", field))
+			}
+		}
+		fields = append(fields, field)
+	}
+	rtype := object.NewRecordType(name, fields)
+
+	bind := func(fnName string, fn func(env *eval.Frame, args ...object.Value) object.Value) {
+		env = env.Bind(object.Symbol(fnName), &eval.Function{Name: fnName, Fn: fn})
+	}
+	constructor := "make-" + name
+	bind(constructor, func(callEnv *eval.Frame, args ...object.Value) object.Value {
+		if err := argsLenError(constructor, args, len(fields)); err != nil {
+			return err
+		}
+		values := make([]object.Value, len(args))
+		for i, a := range args {
+			v := eval.Eval(callEnv, a)
+			if v.Type() == object.ERROR {
+				return v
+			}
+			values[i] = v
+		}
+		return rtype.New(values)
+	})
+	predicate := name + "?"
+	bind(predicate, func(callEnv *eval.Frame, args ...object.Value) object.Value {
+		if err := argsLenError(predicate, args, 1); err != nil {
+			return err
+		}
+		v := eval.Eval(callEnv, args[0])
+		if v.Type() == object.ERROR {
+			return v
+		}
+		if r, ok := v.(*object.Record); ok && r.RecordType() == rtype {
+			return object.Symbol("t")
+		}
+		return object.Nil
+	})
+	for i, field := range fields {
+		i := i
+		accessor := name + "-" + field
+		bind(accessor, func(callEnv *eval.Frame, args ...object.Value) object.Value {
+			if err := argsLenError(accessor, args, 1); err != nil {
+				return err
+			}
+			r, err := evalRecord(callEnv, accessor, rtype, args[0])
+			if err != nil {
+				return err
+			}
+			return r.Get(i)
+		})
+		updater := name + "-with-" + field
+		bind(updater, func(callEnv *eval.Frame, args ...object.Value) object.Value {
+			if err := argsLenError(updater, args, 2); err != nil {
+				return err
+			}
+			r, err := evalRecord(callEnv, updater, rtype, args[0])
+			if err != nil {
+				return err
+			}
+			v := eval.Eval(callEnv, args[1])
+			if v.Type() == object.ERROR {
+				return v
+			}
+			return r.With(i, v)
+		})
+	}
+	return eval.Eval(env, args[2])
+}
+
+func evalRecord(env *eval.Frame, name string, rtype *object.RecordType, arg object.Value) (*object.Record, object.Value) {
+	v := eval.Eval(env, arg)
+	if v.Type() == object.ERROR {
+		return nil, v
+	}
+	if r, ok := v.(*object.Record); ok && r.RecordType() == rtype {
+		return r, nil
+	}
+	return nil, object.Error(fmt.Sprintf("%v wants a %v. got %v", name, rtype.Name, v))
+}
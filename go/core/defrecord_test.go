@@ -0,0 +1,78 @@
+package core
+
+import (
+	"testing"
+)
+
+func TestDefrecord(t *testing.T) {
+
+	tests := []coreTest{{
+		input: "(defrecord point (x y) (make-point 1 2))",
+		want:  "#point{x 1 y 2}",
+	}, {
+		input: "(defrecord point (x y) (point-y (make-point 1 (+ 1 1))))",
+		want:  "2",
+	}, {
+		input: "(defrecord point (x y) (point? (make-point 1 2)))",
+		want:  "t",
+	}, {
+		input: "(defrecord point (x y) (point? '(1 2)))",
+		want:  "()",
+	}, {
+		input: "(defrecord point (x y) (point-with-x (make-point 1 2) 5))",
+		want:  "#point{x 5 y 2}",
+	}, {
+		input: "(defrecord point (x y) ((lambda (p) (cons (point-x (point-with-x p 5)) (cons (point-x p) ()))) (make-point 1 2)))",
+		want:  "(5 1)",
+	}, {
+		input: "(defrecord point (x y) (equal (make-point 1 '(2)) (make-point 1 '(2))))",
+		want:  "t",
+	}, {
+		input: "(defrecord point (x y) (eq (make-point 1 2) (make-point 2 1)))",
+		want:  "()",
+	}, {
+		input: "(defrecord a (x) (defrecord b (x) (eq (make-a 1) (make-b 1))))",
+		want:  "()",
+	}, {
+		input: "(defrecord a (x) (label old (make-a 1) (defrecord a (x) (a? old))))",
+		want:  "()",
+	}, {
+		input:   "(defrecord a (x) (defrecord b (x) (a-x (make-b 1))))",
+		wantErr: true,
+	}, {
+		input:   "(defrecord point (x y) (make-point 1))",
+		wantErr: true,
+	}, {
+		input:   "(defrecord point (x x) ())",
+		wantErr: true,
+	}, {
+		input:   "(defrecord point (x 1) ())",
+		wantErr: true,
+	}, {
+		input:   "(defrecord (point) (x) ())",
+		wantErr: true,
+	}, {
+		input: "(defrecord empty () (make-empty))",
+		want:  "#empty{}",
+	}, {
+		input: "(defrecord point (x y) (get (hash-map (make-point 1 2) 'found) (make-point 1 2)))",
+		want:  "found",
+	}, {
+		input:   "(defrecord point (x) (+ (make-point 1) 1))",
+		wantErr: true,
+	}, {
+		input:   "(defrecord NUMBER (x) (+ (make-NUMBER 1) 1))",
+		wantErr: true,
+	}, {
+		input:   "(defrecord float (x) (< (make-float 1) 2))",
+		wantErr: true,
+	}, {
+		input:   "(defrecord ERROR (x) (make-ERROR 1))",
+		wantErr: true,
+	}, {
+		input: "(defrecord point (x) (type-of (make-point 1)))",
+		want:  "point",
+	}}
+
+	testCore(t, Env, tests)
+}
@@ -5,8 +5,21 @@ import (
 	"dabble/object"
 )
 
+// Eq returns t if its arguments are the same object. Numbers, symbols,
+// strings, characters and bytes are the same when their values are, and
+// lists when their elements are, but vectors, maps, sets and records are
+// only the same as themselves.
 func Eq(env *eval.Frame, args ...object.Value) object.Value {
-	if err := argsLenError("eq", args, 2); err != nil {
+	return compare(env, "eq", object.Identical, args)
+}
+
+// Equal returns t if its arguments are structurally equal.
+func Equal(env *eval.Frame, args ...object.Value) object.Value {
+	return compare(env, "equal", object.Equal, args)
+}
+
+func compare(env *eval.Frame, name string, same func(a, b object.Value) bool, args []object.Value) object.Value {
+	if err := argsLenError(name, args, 2); err != nil {
 		return err
 	}
 	a := eval.Eval(env, args[0])
@@ -17,21 +30,8 @@ func Eq(env *eval.Frame, args ...object.Value) object.Value {
 	if b.Type() == object.ERROR {
 		return b
 	}
-	if a.Type() != b.Type() {
-		return object.Nil
-	}
-	if a.Type() != object.CELL {
-		if a == b {
-			return object.Symbol("t")
-		} else {
-			return object.Nil
-		}
-	}
-	if Eq(env, a.First(), b.First()).Type() == object.NIL {
-		return object.Nil
-	}
-	if Eq(env, a.Rest(), b.Rest()).Type() == object.NIL {
-		return object.Nil
+	if same(a, b) {
+		return object.Symbol("t")
 	}
-	return object.Symbol("t")
+	return object.Nil
 }
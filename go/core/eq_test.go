@@ -24,6 +24,58 @@ func TestEq(t *testing.T) {
 	}, {
 		input: "(eq 'abc 'cba)",
 		want:  "()",
+	}, {
+		input: "(eq [1] [1])",
+		want:  "()",
+	}, {
+		input: "((lambda (v) (eq v v)) [1])",
+		want:  "t",
+	}, {
+		input: "(eq {a 1} {a 1})",
+		want:  "()",
+	}, {
+		input: `(eq "abc" "abc")`,
+		want:  "t",
+	}, {
+		input: "(eq 100000000000000000000 100000000000000000000)",
+		want:  "t",
+	}, {
+		input: "(eq 1 1.0)",
+		want:  "()",
+	}, {
+		input: "(eq car car)",
+		want:  "t",
+	}, {
+		input: "(eq car cdr)",
+		want:  "()",
+	}}
+
+	testCore(t, Env, tests)
+}
+
+func TestEqual(t *testing.T) {
+
+	tests := []coreTest{{
+		input:   "(equal 1)",
+		wantErr: true,
+	}, {
+		input: "(equal '(1 [2 {a #{b}}]) '(1 [2 {a #{b}}]))",
+		want:  "t",
+	}, {
+		input: "(equal '(1 [2]) '(1 [3]))",
+		want:  "()",
+	}, {
+		input: "(equal [1] [1])",
+		want:  "t",
+	}, {
+		input: "(equal 1/2 0.5)",
+		want:  "()",
+	}, {
+		input: "(equal 'a \"a\")",
+		want:  "()",
+	}, {
+		input: "(equal (chan) (chan))",
+		want:  "()",
 	}}
 
 	testCore(t, Env, tests)
@@ -10,8 +10,12 @@ func Error(_ *eval.Frame, args ...object.Value) object.Value {
 	if err := argsLenError("error", args, 1); err != nil {
 		return err
 	}
-	if args[0].Type() != object.SYMBOL {
+	switch args[0].Type() {
+	case object.SYMBOL:
+		return object.Error(args[0].(object.Symbol))
+	case object.STRING:
+		return object.Error(args[0].(object.String))
+	default:
 		return object.Error(fmt.Sprintf("non-symbol error: %v", args[0]))
 	}
-	return object.Error(args[0].(object.Symbol))
 }
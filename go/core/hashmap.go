@@ -0,0 +1,129 @@
+package core
+
+import (
+	"dabble/eval"
+	"dabble/object"
+	"fmt"
+)
+
+// HashMap returns a map of alternating keys and values.
+func HashMap(env *eval.Frame, args ...object.Value) object.Value {
+	if len(args)%2 != 0 {
+		return object.Error(fmt.Sprintf("hash-map wants an even number of args. got %v", len(args)))
+	}
+	return assocAll(env, "hash-map", object.EmptyMap, args)
+}
+
+// Get returns the value for a key in a map. (get m k default) returns
+// default when k is absent, otherwise nil is returned.
+func Get(env *eval.Frame, args ...object.Value) object.Value {
+	if len(args) != 2 && len(args) != 3 {
+		return object.Error(fmt.Sprintf("get wants 2 or 3 arg(s). got %v", len(args)))
+	}
+	m, err := evalMap(env, "get", args[0])
+	if err != nil {
+		return err
+	}
+	key := eval.Eval(env, args[1])
+	if key.Type() == object.ERROR {
+		return key
+	}
+	if v, ok := m.Get(key); ok {
+		return v
+	}
+	if len(args) == 3 {
+		return eval.Eval(env, args[2])
+	}
+	return object.Nil
+}
+
+// Assoc returns a map with alternating keys and values added or replaced.
+func Assoc(env *eval.Frame, args ...object.Value) object.Value {
+	if len(args) == 0 || len(args)%2 != 1 {
+		return object.Error(fmt.Sprintf("assoc wants a map and pairs of keys and values. got %v arg(s)", len(args)))
+	}
+	m, err := evalMap(env, "assoc", args[0])
+	if err != nil {
+		return err
+	}
+	return assocAll(env, "assoc", m, args[1:])
+}
+
+// Dissoc returns a map without the given keys.
+func Dissoc(env *eval.Frame, args ...object.Value) object.Value {
+	if len(args) == 0 {
+		return object.Error("dissoc wants at least 1 arg(s). got 0")
+	}
+	m, err := evalMap(env, "dissoc", args[0])
+	if err != nil {
+		return err
+	}
+	for _, a := range args[1:] {
+		key := eval.Eval(env, a)
+		if key.Type() == object.ERROR {
+			return key
+		}
+		m = m.Dissoc(key)
+	}
+	return m
+}
+
+// Keys returns a list of the keys of a map.
+func Keys(env *eval.Frame, args ...object.Value) object.Value {
+	return mapList(env, "keys", args, func(k, v object.Value) object.Value { return k })
+}
+
+// Vals returns a list of the values of a map, in the same order as keys.
+func Vals(env *eval.Frame, args ...object.Value) object.Value {
+	return mapList(env, "vals", args, func(k, v object.Value) object.Value { return v })
+}
+
+func mapList(env *eval.Frame, name string, args []object.Value, pick func(k, v object.Value) object.Value) object.Value {
+	if err := argsLenError(name, args, 1); err != nil {
+		return err
+	}
+	m, err := evalMap(env, name, args[0])
+	if err != nil {
+		return err
+	}
+	var picked []object.Value
+	m.Range(func(k, v object.Value) bool {
+		picked = append(picked, pick(k, v))
+		return true
+	})
+	list := object.Value(object.Nil)
+	for i := len(picked) - 1; i >= 0; i-- {
+		list = object.Cell(picked[i], list)
+	}
+	return list
+}
+
+func assocAll(env *eval.Frame, name string, m *object.Map, args []object.Value) object.Value {
+	for i := 0; i < len(args); i += 2 {
+		key := eval.Eval(env, args[i])
+		if key.Type() == object.ERROR {
+			return key
+		}
+		value := eval.Eval(env, args[i+1])
+		if value.Type() == object.ERROR {
+			return value
+		}
+		var ok bool
+		m, ok = m.Assoc(key, value)
+		if !ok {
+			return object.Error(fmt.Sprintf("%v unhashable key: %v", name, key))
+		}
+	}
+	return m
+}
+
+func evalMap(env *eval.Frame, name string, arg object.Value) (*object.Map, object.Value) {
+	m := eval.Eval(env, arg)
+	if m.Type() == object.ERROR {
+		return nil, m
+	}
+	if m.Type() != object.MAP {
+		return nil, object.Error(fmt.Sprintf("%v non-map: %v", name, m))
+	}
+	return m.(*object.Map), nil
+}
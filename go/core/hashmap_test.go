@@ -0,0 +1,81 @@
+package core
+
+import (
+	"testing"
+)
+
+func TestHashMap(t *testing.T) {
+
+	tests := []coreTest{{
+		input: "{a 1}",
+		want:  "{a 1}",
+	}, {
+		input: "(hash-map 'a (+ 1 2))",
+		want:  "{a 3}",
+	}, {
+		input:   "(hash-map 'a)",
+		wantErr: true,
+	}, {
+		input:   "(hash-map (chan) 1)",
+		wantErr: true,
+	}, {
+		input: "(get {a 1 b 2} 'b)",
+		want:  "2",
+	}, {
+		input: "(get {a 1} 'c)",
+		want:  "()",
+	}, {
+		input: "(get {a 1} 'c 'none)",
+		want:  "none",
+	}, {
+		input: "(get {(1 2) x [3] y} '(1 2))",
+		want:  "x",
+	}, {
+		input: "(get {(1 2) x [3] y} (vector 3))",
+		want:  "y",
+	}, {
+		input: "(get {100000000000000000000 big} (* 10000000000 10000000000))",
+		want:  "big",
+	}, {
+		input: "(get {1/2 half} 0.5)",
+		want:  "()",
+	}, {
+		input:   "(get '(a 1) 'a)",
+		wantErr: true,
+	}, {
+		input: "(assoc {} 'a 1)",
+		want:  "{a 1}",
+	}, {
+		input: "(get (assoc {a 1} 'a 2 'b 3) 'a)",
+		want:  "2",
+	}, {
+		input: "((lambda (m) (cons (get (assoc m 'a 2) 'a) (cons (get m 'a) ()))) {a 1})",
+		want:  "(2 1)",
+	}, {
+		input:   "(assoc {} 'a)",
+		wantErr: true,
+	}, {
+		input: "(dissoc {a 1 b 2} 'a 'c)",
+		want:  "{b 2}",
+	}, {
+		input: "(keys {a 1})",
+		want:  "(a)",
+	}, {
+		input: "(vals {a 1})",
+		want:  "(1)",
+	}, {
+		input: "(keys {})",
+		want:  "()",
+	}, {
+		input: "(equal (assoc {a 1} 'b 2) (assoc {b 2} 'a 1))",
+		want:  "t",
+	}, {
+		input: "(eq {a 1} {a 2})",
+		want:  "()",
+	}, {
+		input: "(eq {a 1} [a 1])",
+		want:  "()",
+	}}
+
+	testCore(t, Env, tests)
+}
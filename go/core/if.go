@@ -13,7 +13,7 @@ func If(env *eval.Frame, args ...object.Value) object.Value {
 	if cond.Type() == object.ERROR {
 		return cond
 	}
-	eval.T("if condition evaluted to %v", cond)
+	env.T("if condition evaluted to %v", cond)
 	if cond.Type() == object.NIL {
 		return eval.Eval(env, args[2])
 	} else {
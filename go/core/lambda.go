@@ -28,24 +28,38 @@ func Lambda(env *eval.Frame, args ...object.Value) object.Value {
 }
 
 func makeClosure(env *eval.Frame, free []object.Symbol, form object.Value) *eval.Function {
+	return makeRecurClosure(env, free, form, nil)
+}
+
+func makeRecurClosure(env *eval.Frame, free []object.Symbol, form object.Value, recur *eval.Function) *eval.Function {
 	var function *eval.Function
 	function = &eval.Function{
 		Name: "closure",
-		Fn: func(_ *eval.Frame, args ...object.Value) object.Value {
+		Fn: func(callerEnv *eval.Frame, args ...object.Value) object.Value {
 			if err := argsLenError("lambda args", args, len(free)); err != nil {
 				return err
 			}
+			// Bindings go on a fresh frame for each call. The closure
+			// may be called from several goroutines at once.
+			callEnv := env.Within(callerEnv)
 			for i, f := range free {
-				value := eval.Eval(env, args[i])
+				value := eval.Eval(callerEnv, args[i])
 				if value.Type() == object.ERROR {
 					return value
 				}
-				env = env.Bind(f, value)
+				callEnv = callEnv.Bind(f, value)
 			}
-			eval.T(fmt.Sprintf("setting recur point to %v", function))
-			env = env.Call(function)
-			return eval.Eval(env, form)
+			point := recur
+			if point == nil {
+				point = function
+			}
+			callerEnv.T("setting recur point to %v", point)
+			callEnv = callEnv.Call(point)
+			return eval.Eval(callEnv, form)
 		},
 	}
+	function.WithRecur = func(recur *eval.Function) *eval.Function {
+		return makeRecurClosure(env, free, form, recur)
+	}
 	return function
 }
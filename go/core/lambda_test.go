@@ -38,6 +38,17 @@ func TestLambda(t *testing.T) {
 	}, {
 		input: "((lambda (a) (+ 4 a)) 1)",
 		want:  "5",
+	}, {
+		// Arguments are evaluated where the closure is called, not
+		// where it was made.
+		input: "(label f (lambda (x) x) (label y 5 (f y)))",
+		want:  "5",
+	}, {
+		input: "(label y 1 (label f (lambda (x) x) (label y 2 (f y))))",
+		want:  "2",
+	}, {
+		input: "(label a 1 (label f (lambda (x) (+ a x)) (label a 10 (f a))))",
+		want:  "11",
 	}, {
 		input:   "((lambda () 1) 2)",
 		wantErr: true,
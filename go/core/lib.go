@@ -2,11 +2,10 @@ package core
 
 import (
 	"dabble/eval"
-	"dabble/lexer"
 	"dabble/object"
 	"dabble/parser"
 	"fmt"
-	"io/ioutil"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -25,6 +24,7 @@ func init() {
 		"cdr":     Cdr,
 		"cons":    Cons,
 		"eq":      Eq,
+		"equal":   Equal,
 		"if":      If,
 		"label":   Label,
 		"lambda":  Lambda,
@@ -34,6 +34,107 @@ func init() {
 		"recur":   Recur,
 		"error":   Error,
 		"apply":   Apply,
+
+		"defrecord": Defrecord,
+
+		"compare": Compare,
+		"sort":    Sort,
+
+		"type-of":   TypeOf,
+		"error?":    IsError,
+		"number?":   IsNumber,
+		"integer?":  IsInteger,
+		"rational?": IsRational,
+		"float?":    IsFloat,
+		"symbol?":   IsSymbol,
+		"string?":   IsString,
+		"char?":     IsChar,
+		"bytes?":    IsBytes,
+		"cons?":     IsCons,
+		"null?":     IsNull,
+		"list?":     IsList,
+		"vector?":   IsVector,
+		"map?":      IsMap,
+		"set?":      IsSet,
+		"record?":   IsRecord,
+		"function?": IsFunction,
+		"promise?":  IsPromise,
+		"future?":   IsFuture,
+		"channel?":  IsChannel,
+		"actor?":    IsActor,
+
+		"memo":       Memo,
+		"memo-clear": MemoClear,
+
+		"delay":       Delay,
+		"force":       Force,
+		"cons-stream": ConsStream,
+
+		"spawn":  Spawn,
+		"await":  Await,
+		"chan":   Chan,
+		"send":   Send,
+		"recv":   Recv,
+		"select": Select,
+		"pmap":   Pmap,
+
+		"spawn-actor": SpawnActor,
+		"send!":       SendBang,
+		"receive":     Receive,
+		"link":        Link,
+
+		"string-length":  StringLength,
+		"substring":      Substring,
+		"string-append":  StringAppend,
+		"symbol->string": SymbolToString,
+		"string->symbol": StringToSymbol,
+
+		"char->integer":    CharToInteger,
+		"integer->char":    IntegerToChar,
+		"char->symbol":     CharToSymbol,
+		"symbol->char":     SymbolToChar,
+		"char-letter?":     CharLetter,
+		"char-digit?":      CharDigit,
+		"char-whitespace?": CharWhitespace,
+
+		"vector":        Vector,
+		"vector-ref":    VectorRef,
+		"vector-length": VectorLength,
+		"vector-set":    VectorSet,
+		"list->vector":  ListToVector,
+		"vector->list":  VectorToList,
+
+		"hash-map": HashMap,
+		"get":      Get,
+		"assoc":    Assoc,
+		"dissoc":   Dissoc,
+		"keys":     Keys,
+		"vals":     Vals,
+
+		"hash-set":     HashSet,
+		"member":       Member,
+		"union":        Union,
+		"intersection": Intersection,
+		"difference":   Difference,
+		"list->set":    ListToSet,
+		"set->list":    SetToList,
+
+		"bytes-length":  BytesLength,
+		"bytes-ref":     BytesRef,
+		"bytes-slice":   BytesSlice,
+		"bytes-append":  BytesAppend,
+		"string->bytes": StringToBytes,
+		"bytes->string": BytesToString,
+		"list->bytes":   ListToBytes,
+		"bytes->list":   BytesToList,
+
+		"+":        Add,
+		"-":        Sub,
+		"*":        Mul,
+		"/":        Div,
+		"quotient": Quotient,
+		"mod":      Mod,
+		"<":        Less,
 	} {
 		function := &eval.Function{
 			Name: name,
@@ -56,20 +157,10 @@ func init() {
 			return nil
 		}
 		symbol := info.Name()[:len(info.Name())-len(".lisp")]
-		bytes, err := ioutil.ReadFile(path)
+		value, err := loadFile(path)
 		if err != nil {
 			return err
 		}
-		l := lexer.New(string(bytes))
-		p := parser.New(l)
-		program, err := p.ParseProgram()
-		if err != nil {
-			return err
-		}
-		value := eval.Eval(Env, program)
-		if value.Type() == object.ERROR {
-			panic(fmt.Sprintf("%q %v", info.Name(), string(value.(object.Error))))
-		}
 		if value.Type() == object.FUNCTION {
 			value.(*eval.Function).Name = symbol
 		}
@@ -81,3 +172,28 @@ func init() {
 	}
 	Env = Env.BindAll(lib)
 }
+
+// loadFile evaluates the forms of a file in order and returns the value of
+// the last.
+func loadFile(path string) (object.Value, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	r := parser.NewReader(f)
+	var value object.Value = object.Nil
+	for {
+		form, err := r.Read()
+		if err == io.EOF {
+			return value, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%v:%v", path, err)
+		}
+		value = eval.Eval(Env, form.Value)
+		if value.Type() == object.ERROR {
+			panic(fmt.Sprintf("%v:%v: %v", path, form.Pos, string(value.(object.Error))))
+		}
+	}
+}
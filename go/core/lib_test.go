@@ -2,10 +2,9 @@ package core
 
 import (
 	"dabble/eval"
-	"dabble/lexer"
 	"dabble/object"
 	"dabble/parser"
-	"io/ioutil"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -26,22 +25,26 @@ func TestLib(t *testing.T) {
 				t.Errorf(err.Error())
 				return
 			}
-			bytes, err := ioutil.ReadFile(path)
+			f, err := os.Open(path)
 			if err != nil {
 				t.Errorf(err.Error())
 				return
 			}
-			l := lexer.New(string(bytes))
-			p := parser.New(l)
-			program, err := p.ParseProgram()
-			if err != nil {
-				t.Errorf(err.Error())
-				return
-			}
-			value := eval.Eval(Env, program)
-			if value.Type() != object.SYMBOL || value.(object.Symbol) != "t" {
-				t.Errorf("%v", value)
-				return
+			defer f.Close()
+			r := parser.NewReader(f)
+			for {
+				form, err := r.Read()
+				if err == io.EOF {
+					return
+				}
+				if err != nil {
+					t.Errorf(err.Error())
+					return
+				}
+				value := eval.Eval(Env, form.Value)
+				if value.Type() != object.SYMBOL || value.(object.Symbol) != "t" {
+					t.Errorf("%v: %v", form.Pos, value)
+				}
 			}
 		})
 		return nil
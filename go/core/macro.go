@@ -50,11 +50,11 @@ func makeMacro(macroEnv *eval.Frame, free []object.Symbol, haveRest bool, form o
 			if !haveRest && len(args) != len(free) {
 				return object.Error("wrong number of arguments to macro")
 			}
-			eval.T(fmt.Sprintf("setting recur point to %v", function))
-			macroEnv = macroEnv.Call(function)
+			env.T("setting recur point to %v", function)
+			callEnv := macroEnv.Within(env).Call(function)
 			var i int
 			for i = 0; i < len(free)-1; i++ {
-				macroEnv = macroEnv.Bind(free[i], args[i])
+				callEnv = callEnv.Bind(free[i], args[i])
 			}
 			var rest object.Value
 			if haveRest {
@@ -62,12 +62,12 @@ func makeMacro(macroEnv *eval.Frame, free []object.Symbol, haveRest bool, form o
 				for j := len(args) - 1; j >= i; j-- {
 					rest = object.Cell(args[j], rest)
 				}
-				macroEnv = macroEnv.Bind(free[i], rest)
+				callEnv = callEnv.Bind(free[i], rest)
 			} else {
-				macroEnv = macroEnv.Bind(free[i], args[i])
+				callEnv = callEnv.Bind(free[i], args[i])
 			}
-			expandedForm := eval.Eval(macroEnv, form)
-			eval.T("expanded macro form: %v", expandedForm)
+			expandedForm := eval.Eval(callEnv, form)
+			callEnv.T("expanded macro form: %v", expandedForm)
 			if expandedForm.Type() == object.ERROR {
 				return expandedForm
 			}
@@ -0,0 +1,197 @@
+package core
+
+import (
+	"container/list"
+	"dabble/eval"
+	"dabble/object"
+	"fmt"
+	"sync"
+)
+
+// Memo wraps a function with a cache of results keyed on its evaluated
+// arguments, compared like equal. (memo f) caches every call and (memo f n)
+// keeps only the n most recently used results. Calls to recur within f go
+// through the cache.
+func Memo(env *eval.Frame, args ...object.Value) object.Value {
+	if len(args) != 1 && len(args) != 2 {
+		return object.Error(fmt.Sprintf("memo wants 1 or 2 arg(s). got %v", len(args)))
+	}
+	f := eval.Eval(env, args[0])
+	if f.Type() == object.ERROR {
+		return f
+	}
+	if f.Type() != object.FUNCTION {
+		return object.Error(fmt.Sprintf("memo non-function: %v", f))
+	}
+	size := 0
+	if len(args) == 2 {
+		n := eval.Eval(env, args[1])
+		if n.Type() == object.ERROR {
+			return n
+		}
+		if n.Type() != object.NUMBER || n.(object.Number) < 1 {
+			return object.Error(fmt.Sprintf("memo size must be a positive number: %v", n))
+		}
+		size = int(n.(object.Number))
+	}
+	return makeMemo(f.(*eval.Function), size)
+}
+
+// MemoClear empties the cache of a function returned by memo.
+func MemoClear(env *eval.Frame, args ...object.Value) object.Value {
+	if err := argsLenError("memo-clear", args, 1); err != nil {
+		return err
+	}
+	f := eval.Eval(env, args[0])
+	if f.Type() == object.ERROR {
+		return f
+	}
+	var c *memoCache
+	if f.Type() == object.FUNCTION {
+		c, _ = f.(*eval.Function).State.(*memoCache)
+	}
+	if c == nil {
+		return object.Error(fmt.Sprintf("memo-clear non-memo: %v", f))
+	}
+	c.clear()
+	return object.Symbol("t")
+}
+
+func makeMemo(f *eval.Function, size int) *eval.Function {
+	c := &memoCache{size: size}
+	c.clear()
+	var function *eval.Function
+	function = &eval.Function{
+		Name: "memo " + f.Name,
+		Fn: func(env *eval.Frame, args ...object.Value) object.Value {
+			values := make([]object.Value, len(args))
+			literals := make([]object.Value, len(args))
+			cacheable := true
+			for i, a := range args {
+				values[i] = eval.Eval(env, a)
+				if values[i].Type() == object.ERROR {
+					return values[i]
+				}
+				var ok bool
+				if literals[i], ok = literal(values[i]); !ok {
+					cacheable = false
+				}
+			}
+			inner := f
+			if f.WithRecur != nil {
+				inner = f.WithRecur(function)
+			}
+			if !cacheable {
+				env.T("not caching %v", values)
+				return inner.Fn(env, args...)
+			}
+			if v, ok := c.get(values); ok {
+				env.T("cached %v", v)
+				return v
+			}
+			v := inner.Fn(env, literals...)
+			c.put(values, v)
+			return v
+		},
+		State: c,
+	}
+	return function
+}
+
+// memoCache maps argument lists to results. Entries are bucketed by the
+// hash of the arguments, which is the same for any arguments that are
+// equal, and kept in a list from most to least recently used.
+type memoCache struct {
+	mu      sync.Mutex
+	size    int
+	buckets map[uint64][]*list.Element
+	lru     *list.List
+}
+
+type memoEntry struct {
+	key   uint64
+	args  []object.Value
+	value object.Value
+}
+
+func (c *memoCache) get(args []object.Value) (object.Value, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, e := range c.buckets[memoKey(args)] {
+		if equalArgs(e.Value.(*memoEntry).args, args) {
+			c.lru.MoveToFront(e)
+			return e.Value.(*memoEntry).value, true
+		}
+	}
+	return nil, false
+}
+
+func (c *memoCache) put(args []object.Value, value object.Value) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	key := memoKey(args)
+	for _, e := range c.buckets[key] {
+		if equalArgs(e.Value.(*memoEntry).args, args) {
+			e.Value.(*memoEntry).value = value
+			c.lru.MoveToFront(e)
+			return
+		}
+	}
+	c.buckets[key] = append(c.buckets[key], c.lru.PushFront(&memoEntry{key, args, value}))
+	if c.size > 0 && c.lru.Len() > c.size {
+		c.evict(c.lru.Back())
+	}
+}
+
+func (c *memoCache) evict(e *list.Element) {
+	key := e.Value.(*memoEntry).key
+	bucket := c.buckets[key]
+	for i, b := range bucket {
+		if b == e {
+			bucket = append(bucket[:i], bucket[i+1:]...)
+			break
+		}
+	}
+	if len(bucket) == 0 {
+		delete(c.buckets, key)
+	} else {
+		c.buckets[key] = bucket
+	}
+	c.lru.Remove(e)
+}
+
+func (c *memoCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.buckets = map[uint64][]*list.Element{}
+	c.lru = list.New()
+}
+
+func (c *memoCache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
+
+// memoKey hashes arguments. Arguments which can't be hashed, such as
+// functions, share a bucket and are told apart by equalArgs.
+func memoKey(args []object.Value) uint64 {
+	var key uint64 = 14695981039346656037
+	for _, a := range args {
+		h, _ := object.Hash(a)
+		key = (key ^ h) * 1099511628211
+	}
+	return key
+}
+
+func equalArgs(a, b []object.Value) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !object.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
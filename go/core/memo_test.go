@@ -0,0 +1,139 @@
+package core
+
+import (
+	"dabble/eval"
+	"dabble/object"
+	"strconv"
+	"testing"
+)
+
+func TestMemo(t *testing.T) {
+
+	var calls int
+	count := &eval.Function{Name: "count", Fn: func(env *eval.Frame, args ...object.Value) object.Value {
+		if err := argsLenError("count", args, 1); err != nil {
+			return err
+		}
+		calls++
+		return eval.Eval(env, args[0])
+	}}
+	arith := func(name string, op func(a, b object.Number) object.Number) *eval.Function {
+		return &eval.Function{Name: name, Fn: func(env *eval.Frame, args ...object.Value) object.Value {
+			a := eval.Eval(env, args[0])
+			b := eval.Eval(env, args[1])
+			return op(a.(object.Number), b.(object.Number))
+		}}
+	}
+	env := Env.Bind("count", count).
+		Bind("+", arith("+", func(a, b object.Number) object.Number { return a + b })).
+		Bind("-", arith("-", func(a, b object.Number) object.Number { return a - b }))
+
+	tests := []struct {
+		input     string
+		want      string
+		wantErr   bool
+		wantCalls int
+	}{{
+		input:     "(label f (memo count) (cons (f 1) (cons (f 1) ())))",
+		want:      "(1 1)",
+		wantCalls: 1,
+	}, {
+		input:     "(label f (memo count) (cons (f '(1 a)) (cons (f (cons 1 '(a))) ())))",
+		want:      "((1 a) (1 a))",
+		wantCalls: 1,
+	}, {
+		input:     "(label f (memo count) (cons (f 1) (cons (f 'x) ())))",
+		want:      "(1 x)",
+		wantCalls: 2,
+	}, {
+		input:     "(label f (memo count 1) (cons (f 1) (cons (f 2) (cons (f 1) ()))))",
+		want:      "(1 2 1)",
+		wantCalls: 3,
+	}, {
+		input:     "(label f (memo count 2) (cons (f 1) (cons (f 2) (cons (f 1) ()))))",
+		want:      "(1 2 1)",
+		wantCalls: 2,
+	}, {
+		input:     "(label f (memo count) (cons (f 1) (label c (memo-clear f) (cons (f 1) ()))))",
+		want:      "(1 1)",
+		wantCalls: 2,
+	}, {
+		input: `
+(label fib
+  (memo (lambda (n)
+    (count (if (eq n 0) 0
+      (if (eq n 1) 1
+        (+ (recur (- n 1)) (recur (- n 2))))))))
+  (fib 40))`,
+		want:      "102334155",
+		wantCalls: 41,
+	}, {
+		input:     "(label f (memo count) (cons (f 0.0) (cons (f -0.0) ())))",
+		want:      "(0.0 0.0)",
+		wantCalls: 1,
+	}, {
+		input:     "(label p (delay 1) (label f (memo count) (cons (f p) (label x (force p) (cons (f p) ())))))",
+		want:      "(<promise 1> <promise 1>)",
+		wantCalls: 1,
+	}, {
+		input:   "(memo 1)",
+		wantErr: true,
+	}, {
+		input:   "(memo count 0)",
+		wantErr: true,
+	}, {
+		input:   "(memo-clear count)",
+		wantErr: true,
+	}, {
+		input:   "((memo count) (error oops))",
+		wantErr: true,
+	}}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			calls = 0
+			value := parse(t, tt.input)
+			got := eval.Eval(env, value)
+			if tt.wantErr {
+				if got.Type() != object.ERROR {
+					t.Errorf("given %v. want err. got %v", value, got)
+				}
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("given %v. want %v. got %v", value, tt.want, got)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("given %v. want %v call(s). got %v", value, tt.wantCalls, calls)
+			}
+		})
+	}
+}
+
+func TestMemoCache(t *testing.T) {
+	c := &memoCache{size: 2}
+	c.clear()
+	one := []object.Value{object.Number(1)}
+	two := []object.Value{object.Number(2)}
+	three := []object.Value{object.Symbol("1")}
+	c.put(one, object.Symbol("a"))
+	c.put(two, object.Symbol("b"))
+	if _, ok := c.get(one); !ok {
+		t.Errorf("want 1 cached")
+	}
+	// Symbol 1 prints like number 1 but is not equal so it gets its own
+	// entry, evicting the least recently used 2.
+	c.put(three, object.Symbol("c"))
+	if _, ok := c.get(two); ok {
+		t.Errorf("want 2 evicted")
+	}
+	if v, ok := c.get(one); !ok || v != object.Symbol("a") {
+		t.Errorf("want 1 cached as a. got %v", v)
+	}
+	if v, ok := c.get(three); !ok || v != object.Symbol("c") {
+		t.Errorf("want symbol 1 cached as c. got %v", v)
+	}
+	if c.len() != 2 {
+		t.Errorf("want 2 entries. got %v", c.len())
+	}
+}
@@ -0,0 +1,248 @@
+package core
+
+import (
+	"dabble/eval"
+	"dabble/object"
+)
+
+// Optimize returns a program which evaluates to the same value as value in
+// env. Calls to the pure builtins car, cdr, cons, atom and eq on constant
+// arguments are folded, if forms with a constant condition are replaced by
+// the branch taken and trivial lambdas applied to constants are inlined.
+//
+// Symbols only refer to builtins where env binds them to the builtins of Env
+// and no enclosing label or lambda shadows them. Arguments to macros and
+// other user functions are left alone since macros see them unevaluated.
+func Optimize(env *eval.Frame, value object.Value) object.Value {
+	return optimize(env, value)
+}
+
+// unknown shadows symbols bound by the program itself. Their values are not
+// known until it runs.
+var unknown = object.Error("optimize: unknown binding")
+
+var pure = map[object.Symbol]bool{
+	"car":  true,
+	"cdr":  true,
+	"cons": true,
+	"atom": true,
+	"eq":   true,
+}
+
+func optimize(env *eval.Frame, value object.Value) object.Value {
+	if value.Type() != object.CELL {
+		return value
+	}
+	head := value.First()
+	args := []object.Value{}
+	for rest := value.Rest(); rest.Type() == object.CELL; rest = rest.Rest() {
+		args = append(args, rest.First())
+	}
+	if head.Type() == object.CELL {
+		return optimizeApplication(env, optimize(env, head), args)
+	}
+	if head.Type() != object.SYMBOL || !isBuiltin(env, head.(object.Symbol)) {
+		return value
+	}
+	name := head.(object.Symbol)
+	switch {
+	case pure[name]:
+		folded := make([]object.Value, len(args))
+		constant := true
+		for i, a := range args {
+			folded[i] = optimize(env, a)
+			if _, ok := constantValue(env, folded[i]); !ok {
+				constant = false
+			}
+		}
+		if constant {
+			fn := env.Resolve(name).(*eval.Function)
+			result := fn.Fn(env, folded...)
+			if result.Type() != object.ERROR {
+				if l, ok := literal(result); ok {
+					return l
+				}
+			}
+		}
+		return listOf(head, folded...)
+	case name == "if":
+		if len(args) != 3 {
+			return value
+		}
+		cond := optimize(env, args[0])
+		if c, ok := constantValue(env, cond); ok {
+			if c.Type() == object.NIL {
+				return optimize(env, args[2])
+			}
+			return optimize(env, args[1])
+		}
+		return listOf(head, cond, optimize(env, args[1]), optimize(env, args[2]))
+	case name == "label":
+		if len(args) != 3 || args[0].Type() != object.SYMBOL {
+			return value
+		}
+		bound := env.Bind(args[0].(object.Symbol), unknown)
+		return listOf(head, args[0], optimize(env, args[1]), optimize(bound, args[2]))
+	case name == "lambda":
+		if len(args) != 2 {
+			return value
+		}
+		params, ok := symbols(args[0])
+		if !ok {
+			return value
+		}
+		bound := env
+		for _, p := range params {
+			bound = bound.Bind(p, unknown)
+		}
+		return listOf(head, args[0], optimize(bound, args[1]))
+	case name == "apply":
+		optimized := make([]object.Value, len(args))
+		for i, a := range args {
+			optimized[i] = optimize(env, a)
+		}
+		return listOf(head, optimized...)
+	default:
+		return value
+	}
+}
+
+// optimizeApplication handles a call whose head is itself a form. Only
+// immediately applied lambdas are understood. Their arguments are evaluated
+// so they can be optimized, and the call is inlined when it is trivial: the
+// arguments are constant and the body is a constant or one of the
+// parameters. A call without arguments is replaced by its body.
+func optimizeApplication(env *eval.Frame, head object.Value, args []object.Value) object.Value {
+	if head.Type() != object.CELL || head.First().Type() != object.SYMBOL {
+		return listOf(head, args...)
+	}
+	if head.First().(object.Symbol) != "lambda" || !isBuiltin(env, "lambda") {
+		return listOf(head, args...)
+	}
+	lambdaArgs := []object.Value{}
+	for rest := head.Rest(); rest.Type() == object.CELL; rest = rest.Rest() {
+		lambdaArgs = append(lambdaArgs, rest.First())
+	}
+	if len(lambdaArgs) != 2 {
+		return listOf(head, args...)
+	}
+	params, ok := symbols(lambdaArgs[0])
+	if !ok {
+		return listOf(head, args...)
+	}
+	optimized := make([]object.Value, len(args))
+	constant := true
+	for i, a := range args {
+		optimized[i] = optimize(env, a)
+		if _, ok := constantValue(env, optimized[i]); !ok {
+			constant = false
+		}
+	}
+	body := lambdaArgs[1]
+	if len(params) != len(optimized) || !constant || mentions(body, "recur") {
+		return listOf(head, optimized...)
+	}
+	if len(params) == 0 {
+		return body
+	}
+	if _, ok := constantValue(env, body); ok && body.Type() != object.SYMBOL {
+		return body
+	}
+	if body.Type() == object.SYMBOL {
+		for i := len(params) - 1; i >= 0; i-- {
+			if params[i] == body.(object.Symbol) {
+				return optimized[i]
+			}
+		}
+	}
+	return listOf(head, optimized...)
+}
+
+// isBuiltin reports whether symbol refers to the builtin of the same name.
+func isBuiltin(env *eval.Frame, symbol object.Symbol) bool {
+	v := env.Resolve(symbol)
+	return v.Type() == object.FUNCTION && v == Env.Resolve(symbol)
+}
+
+// constantValue returns what value evaluates to when that doesn't depend on
+// anything but env.
+func constantValue(env *eval.Frame, value object.Value) (object.Value, bool) {
+	switch value.Type() {
+	case object.NUMBER, object.BIGINT, object.RATIO, object.FLOAT, object.NIL, object.STRING, object.CHAR, object.BYTES, object.VECTOR, object.MAP, object.SET:
+		return value, true
+	case object.SYMBOL:
+		v := env.Resolve(value.(object.Symbol))
+		if v.Type() == object.ERROR {
+			return nil, false
+		}
+		return v, true
+	case object.QUOTED:
+		if mentionsUnquote(value.First()) {
+			return nil, false
+		}
+		return eval.Eval(nil, value), true
+	default:
+		return nil, false
+	}
+}
+
+// literal returns a form which evaluates to value in any environment.
+// Values other than symbols and lists evaluate to themselves.
+func literal(value object.Value) (object.Value, bool) {
+	switch value.Type() {
+	case object.ERROR, object.UNQUOTED:
+		return nil, false
+	case object.SYMBOL, object.CELL, object.QUOTED:
+		if mentionsUnquote(value) {
+			return nil, false
+		}
+		return object.Quoted(value), true
+	default:
+		return value, true
+	}
+}
+
+func mentionsUnquote(value object.Value) bool {
+	switch value.Type() {
+	case object.UNQUOTED:
+		return true
+	case object.CELL:
+		return mentionsUnquote(value.First()) || mentionsUnquote(value.Rest())
+	case object.QUOTED:
+		return mentionsUnquote(value.First())
+	default:
+		return false
+	}
+}
+
+func mentions(value object.Value, symbol object.Symbol) bool {
+	switch value.Type() {
+	case object.SYMBOL:
+		return value.(object.Symbol) == symbol
+	case object.CELL:
+		return mentions(value.First(), symbol) || mentions(value.Rest(), symbol)
+	case object.QUOTED, object.UNQUOTED:
+		return mentions(value.First(), symbol)
+	default:
+		return false
+	}
+}
+
+func symbols(params object.Value) ([]object.Symbol, bool) {
+	s := []object.Symbol{}
+	for ; params.Type() == object.CELL; params = params.Rest() {
+		if params.First().Type() != object.SYMBOL {
+			return nil, false
+		}
+		s = append(s, params.First().(object.Symbol))
+	}
+	return s, params.Type() == object.NIL
+}
+
+func listOf(head object.Value, args ...object.Value) object.Value {
+	var l object.Value = object.Nil
+	for i := len(args) - 1; i >= 0; i-- {
+		l = object.Cell(args[i], l)
+	}
+	return object.Cell(head, l)
+}
@@ -0,0 +1,186 @@
+package core
+
+import (
+	"dabble/eval"
+	"dabble/lexer"
+	"dabble/object"
+	"dabble/parser"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestOptimize(t *testing.T) {
+
+	tests := []struct {
+		input string
+		want  string
+	}{{
+		input: "1",
+		want:  "1",
+	}, {
+		input: "(car '(1 2))",
+		want:  "1",
+	}, {
+		input: "(cdr '(1 2))",
+		want:  "'(2)",
+	}, {
+		input: "(cons 1 (cons 2 ()))",
+		want:  "'(1 2)",
+	}, {
+		input: "(atom 'a)",
+		want:  "'(a)",
+	}, {
+		input: "(eq () t)",
+		want:  "()",
+	}, {
+		input: "(eq 'a 'a)",
+		want:  "'t",
+	}, {
+		input: "(if (eq () t) (car x) (cdr '(1 2)))",
+		want:  "'(2)",
+	}, {
+		input: "(if (eq 1 1) (car x) y)",
+		want:  "(car x)",
+	}, {
+		input: "(if x (car '(1)) (cdr '(1)))",
+		want:  "(if x 1 ())",
+	}, {
+		input: "(car (cdr x))",
+		want:  "(car (cdr x))",
+	}, {
+		input: "(car 1 2)",
+		want:  "(car 1 2)",
+	}, {
+		input: "((lambda (x) x) 1)",
+		want:  "1",
+	}, {
+		input: "((lambda (x y) y) 1 (car '(2)))",
+		want:  "2",
+	}, {
+		input: "((lambda (x) 7) '(1))",
+		want:  "7",
+	}, {
+		input: "((lambda () (car '(3))))",
+		want:  "3",
+	}, {
+		input: "((lambda (x) (cons x x)) 1)",
+		want:  "((lambda (x) (cons x x)) 1)",
+	}, {
+		input: "((lambda (x) x) y)",
+		want:  "((lambda (x) x) y)",
+	}, {
+		input: "((lambda () (recur)))",
+		want:  "((lambda () (recur)))",
+	}, {
+		input: "(label car cdr (car '(1 2)))",
+		want:  "(label car cdr (car '(1 2)))",
+	}, {
+		input: "(lambda (if) (if 1 2 3))",
+		want:  "(lambda (if) (if 1 2 3))",
+	}, {
+		input: "(label x 1 (eq x (car '(1))))",
+		want:  "(label x 1 (eq x 1))",
+	}, {
+		input: "(not (eq 1 1))",
+		want:  "(not (eq 1 1))",
+	}, {
+		input: "'(car `(car '(1)))",
+		want:  "'(car `(car '(1)))",
+	}}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			value := parse(t, tt.input)
+			got := Optimize(Env, value)
+			if got.String() != tt.want {
+				t.Errorf("given %v. want %v. got %v", value, tt.want, got)
+			}
+		})
+	}
+}
+
+// TestOptimizeDifferential checks that optimized programs evaluate to the
+// same value as the original programs.
+func TestOptimizeDifferential(t *testing.T) {
+	env := Env.Bind("x", object.Cell(object.Number(1), object.Number(2)))
+
+	programs := []string{
+		"(if (eq () t) (car x) (cdr x))",
+		"(cons (car '(1 2)) (cdr x))",
+		"(eq (atom x) (atom (car x)))",
+		"(label y (cdr '(1 2)) (if (atom y) y (car y)))",
+		"((lambda (a) (cons a a)) (car x))",
+		"((lambda (a) a) (cons 1 ()))",
+		"(and (eq 1 1) (atom 2))",
+		"(let ((a 1)) (eq a (car '(1))))",
+		"(apply cons '(1 (2)))",
+		"(car (error oops))",
+		"(eq 1)",
+		"(if (car '(())) (error no) (car '(1 2)))",
+	}
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 500; i++ {
+		programs = append(programs, generate(r, 4))
+	}
+
+	for i, input := range programs {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			value := parse(t, input)
+			optimized := Optimize(env, value)
+			want := eval.Eval(env, value)
+			got := eval.Eval(env, optimized)
+			if want.Type() == object.ERROR {
+				if got.Type() != object.ERROR {
+					t.Errorf("given %v optimized to %v. want error %v. got %v", value, optimized, want, got)
+				}
+				return
+			}
+			if got.String() != want.String() {
+				t.Errorf("given %v optimized to %v. want %v. got %v", value, optimized, want, got)
+			}
+		})
+	}
+}
+
+func parse(t *testing.T, input string) object.Value {
+	t.Helper()
+	l := lexer.New(input)
+	p := parser.New(l)
+	value, err := p.ParseProgram()
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	return value
+}
+
+// generate returns a random program built from the pure builtins, if and
+// immediately applied lambdas over constants and the variable x.
+func generate(r *rand.Rand, depth int) string {
+	if depth == 0 || r.Intn(4) == 0 {
+		return []string{"1", "2", "()", "t", "x", "'a", "'(1 2)", "'(a (b))"}[r.Intn(8)]
+	}
+	sub := func() string {
+		return generate(r, depth-1)
+	}
+	switch r.Intn(8) {
+	case 0:
+		return fmt.Sprintf("(car %v)", sub())
+	case 1:
+		return fmt.Sprintf("(cdr %v)", sub())
+	case 2:
+		return fmt.Sprintf("(cons %v %v)", sub(), sub())
+	case 3:
+		return fmt.Sprintf("(atom %v)", sub())
+	case 4:
+		return fmt.Sprintf("(eq %v %v)", sub(), sub())
+	case 5:
+		return fmt.Sprintf("(if %v %v %v)", sub(), sub(), sub())
+	case 6:
+		return fmt.Sprintf("((lambda (x y) %v) %v %v)", sub(), sub(), sub())
+	default:
+		return strings.Join([]string{"((lambda ()", sub() + "))"}, " ")
+	}
+}
@@ -0,0 +1,124 @@
+package core
+
+import (
+	"dabble/eval"
+	"dabble/object"
+	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// PmapLimit is bound to the number of calls pmap may run at once. Rebinding
+// it, in Go with Bind or in Dabble with label, sets the limit for the
+// evaluation below it. When unbound pmap uses GOMAXPROCS.
+const PmapLimit = object.Symbol("pmap-limit")
+
+// pmapInput is bound to each input for the call which receives it. The
+// function is passed the symbol rather than the input so that inputs reach
+// it as they are, without being evaluated again. The name can't be read.
+const pmapInput = object.Symbol("pmap input")
+
+// Pmap applies a function to each element of a list, running up to
+// pmap-limit calls in parallel, and returns the results in input order. If
+// any call returns an error the error for the earliest input is returned.
+// Calls for later inputs which haven't started are skipped and those
+// running are cancelled.
+func Pmap(env *eval.Frame, args ...object.Value) object.Value {
+	if err := argsLenError("pmap", args, 2); err != nil {
+		return err
+	}
+	function := eval.Eval(env, args[0])
+	if function.Type() == object.ERROR {
+		return function
+	}
+	if function.Type() != object.FUNCTION {
+		return object.Error(fmt.Sprintf("pmap non-function: %v", function))
+	}
+	list := eval.Eval(env, args[1])
+	if list.Type() == object.ERROR {
+		return list
+	}
+	if list.Type() != object.CELL && list.Type() != object.NIL {
+		return object.Error(fmt.Sprintf("pmap non-list: %v", list))
+	}
+	limit, err := pmapLimit(env)
+	if err != nil {
+		return err
+	}
+
+	inputs := []object.Value{}
+	for ; list.Type() == object.CELL; list = list.Rest() {
+		inputs = append(inputs, list.First())
+	}
+	fn := function.(*eval.Function)
+	results := make([]object.Value, len(inputs))
+
+	// failed, guarded by mu, is the lowest index known to have returned an
+	// error. Inputs after it need not be computed since their results are
+	// discarded.
+	var mu sync.Mutex
+	failed := len(inputs)
+	cancels := make([]func(), len(inputs))
+	next := int64(-1)
+	env = env.Detached()
+	var wg sync.WaitGroup
+	for w := 0; w < limit && w < len(inputs); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				i := int(atomic.AddInt64(&next, 1))
+				if i >= len(inputs) {
+					return
+				}
+				callEnv, cancel := env.Cancellable()
+				mu.Lock()
+				skip := i > failed
+				cancels[i] = cancel
+				mu.Unlock()
+				if skip {
+					return
+				}
+				callEnv = callEnv.Bind(pmapInput, inputs[i])
+				results[i] = object.Protect(func() object.Value {
+					return fn.Fn(callEnv, pmapInput)
+				})
+				if results[i].Type() != object.ERROR {
+					continue
+				}
+				mu.Lock()
+				if i < failed {
+					failed = i
+					for _, cancel := range cancels[i+1:] {
+						if cancel != nil {
+							cancel()
+						}
+					}
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if failed < len(inputs) {
+		return results[failed]
+	}
+	var ret object.Value = object.Nil
+	for i := len(results) - 1; i >= 0; i-- {
+		ret = object.Cell(results[i], ret)
+	}
+	return ret
+}
+
+func pmapLimit(env *eval.Frame) (int, object.Value) {
+	limit := env.Resolve(PmapLimit)
+	if limit.Type() == object.ERROR {
+		return runtime.GOMAXPROCS(0), nil
+	}
+	if limit.Type() != object.NUMBER || limit.(object.Number) < 1 {
+		return 0, object.Error(fmt.Sprintf("%v must be a positive number: %v", PmapLimit, limit))
+	}
+	return int(limit.(object.Number)), nil
+}
@@ -0,0 +1,167 @@
+package core
+
+import (
+	"dabble/eval"
+	"dabble/object"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestPmap(t *testing.T) {
+
+	tests := []coreTest{{
+		input: "(pmap (lambda (x) (cons x x)) '(1 2 3))",
+		want:  "((1 1) (2 2) (3 3))",
+	}, {
+		input: "(pmap car '((1 2) (a b) (() c)))",
+		want:  "(1 a ())",
+	}, {
+		input: "(pmap (lambda (x) x) ())",
+		want:  "()",
+	}, {
+		input: "(label pmap-limit 1 (pmap atom '(1 (2) 3)))",
+		want:  "((1) () (3))",
+	}, {
+		input: "(pmap (lambda (x) (if (eq x 2) (error two) (if (eq x 3) (error three) x))) '(1 2 3 4))",
+		want:  "<error: two>",
+	}, {
+		input:   "(pmap 1 '(1))",
+		wantErr: true,
+	}, {
+		input:   "(pmap car 1)",
+		wantErr: true,
+	}, {
+		input:   "(label pmap-limit 0 (pmap car '(1)))",
+		wantErr: true,
+	}}
+
+	testCore(t, Env, tests)
+}
+
+func TestPmapLimit(t *testing.T) {
+	var running, peak, calls int64
+	work := &eval.Function{Name: "work", Fn: func(env *eval.Frame, args ...object.Value) object.Value {
+		atomic.AddInt64(&calls, 1)
+		n := atomic.AddInt64(&running, 1)
+		defer atomic.AddInt64(&running, -1)
+		for {
+			p := atomic.LoadInt64(&peak)
+			if n <= p || atomic.CompareAndSwapInt64(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		v := eval.Eval(env, args[0])
+		if v == object.Number(0) {
+			return object.Error("zero")
+		}
+		return v
+	}}
+
+	tests := []struct {
+		limit     int
+		input     string
+		want      string
+		wantPeak  int64
+		wantCalls int64
+	}{{
+		limit:     1,
+		input:     "(pmap work '(1 2 3 4 5 6 7 8))",
+		want:      "(1 2 3 4 5 6 7 8)",
+		wantPeak:  1,
+		wantCalls: 8,
+	}, {
+		limit:     4,
+		input:     "(pmap work '(1 2 3 4 5 6 7 8))",
+		want:      "(1 2 3 4 5 6 7 8)",
+		wantPeak:  4,
+		wantCalls: 8,
+	}, {
+		limit:     1,
+		input:     "(pmap work '(1 0 3 4 5 6 7 8))",
+		want:      "<error: zero>",
+		wantPeak:  1,
+		wantCalls: 2,
+	}}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			running, peak, calls = 0, 0, 0
+			env := Env.Bind("work", work).Bind(PmapLimit, object.Number(tt.limit))
+			got := eval.Eval(env, parse(t, tt.input))
+			if got.String() != tt.want {
+				t.Errorf("given %v. want %v. got %v", tt.input, tt.want, got)
+			}
+			if peak > tt.wantPeak {
+				t.Errorf("given limit %v. want at most %v running. got %v", tt.limit, tt.wantPeak, peak)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("given %v. want %v call(s). got %v", tt.input, tt.wantCalls, calls)
+			}
+		})
+	}
+}
+
+func TestPmapFirstError(t *testing.T) {
+	// Later inputs fail sooner, but the error of the earliest input wins.
+	var mu sync.Mutex
+	order := []object.Value{}
+	fail := &eval.Function{Name: "fail", Fn: func(env *eval.Frame, args ...object.Value) object.Value {
+		v := eval.Eval(env, args[0])
+		time.Sleep(time.Duration(10-v.(object.Number)) * time.Millisecond)
+		mu.Lock()
+		order = append(order, v)
+		mu.Unlock()
+		return object.Error("failed " + v.String())
+	}}
+	env := Env.Bind("fail", fail).Bind(PmapLimit, object.Number(8))
+	for i := 0; i < 5; i++ {
+		order = nil
+		got := eval.Eval(env, parse(t, "(pmap fail '(1 2 3 4 5 6 7 8))"))
+		if got.String() != "<error: failed 1>" {
+			t.Errorf("want first error. got %v (completion order %v)", got, order)
+		}
+	}
+}
+
+func TestPmapCancelsRunningCalls(t *testing.T) {
+	// The call for 3 loops until the call for 2 fails and cancels it.
+	started := make(chan struct{})
+	looped := make(chan object.Value, 1)
+	work := &eval.Function{Name: "work", Fn: func(env *eval.Frame, args ...object.Value) object.Value {
+		v := eval.Eval(env, args[0])
+		if v == object.Number(2) {
+			<-started
+			return object.Error("two")
+		}
+		close(started)
+		loop := eval.Eval(env, parse(t, "((lambda (n) (recur n)) 0)"))
+		looped <- loop
+		return loop
+	}}
+	env := Env.Bind("work", work).Bind(PmapLimit, object.Number(2))
+	got := eval.Eval(env, parse(t, "(pmap work '(2 3))"))
+	if got.String() != "<error: two>" {
+		t.Errorf("want <error: two>. got %v", got)
+	}
+	if loop := <-looped; loop.String() != "<error: cancelled>" {
+		t.Errorf("want running call cancelled. got %v", loop)
+	}
+}
+
+func TestPmapPassesValues(t *testing.T) {
+	// Inputs reach the function as they are, even those which evaluate to
+	// something else.
+	inputs := object.Cell(object.Unquoted(object.Symbol("a")), object.Cell(object.Symbol("b"), object.Nil))
+	env := Env.Bind("inputs", inputs)
+	testCore(t, env, []coreTest{{
+		input: "(pmap (lambda (x) x) inputs)",
+		want:  "(`a b)",
+	}, {
+		input: "(pmap (lambda (f) (f 1)) (cons (lambda (x) (cons x x)) ()))",
+		want:  "((1 1))",
+	}})
+}
@@ -7,6 +7,6 @@ import (
 
 func Recur(env *eval.Frame, args ...object.Value) object.Value {
 	lastCaller := env.LastCaller()
-	eval.T("recurring on %v", lastCaller)
+	env.T("recurring on %v", lastCaller)
 	return lastCaller.Fn(env, args...)
 }
@@ -0,0 +1,152 @@
+package core
+
+import (
+	"dabble/eval"
+	"dabble/object"
+	"fmt"
+)
+
+// HashSet returns a set of its arguments.
+func HashSet(env *eval.Frame, args ...object.Value) object.Value {
+	s := object.EmptySet
+	for _, a := range args {
+		v := eval.Eval(env, a)
+		if v.Type() == object.ERROR {
+			return v
+		}
+		var ok bool
+		s, ok = s.Add(v)
+		if !ok {
+			return object.Error(fmt.Sprintf("hash-set unhashable element: %v", v))
+		}
+	}
+	return s
+}
+
+// Member returns t if a value is an element of a set.
+func Member(env *eval.Frame, args ...object.Value) object.Value {
+	if err := argsLenError("member", args, 2); err != nil {
+		return err
+	}
+	s, err := evalSet(env, "member", args[0])
+	if err != nil {
+		return err
+	}
+	v := eval.Eval(env, args[1])
+	if v.Type() == object.ERROR {
+		return v
+	}
+	if s.Contains(v) {
+		return object.Symbol("t")
+	}
+	return object.Nil
+}
+
+// Union returns the elements in any of its sets. (union) is the empty set.
+func Union(env *eval.Frame, args ...object.Value) object.Value {
+	sets, err := evalSets(env, "union", args)
+	if err != nil {
+		return err
+	}
+	result := object.EmptySet
+	for _, s := range sets {
+		result = result.Union(s)
+	}
+	return result
+}
+
+// Intersection returns the elements in all of its sets.
+func Intersection(env *eval.Frame, args ...object.Value) object.Value {
+	if len(args) == 0 {
+		return object.Error("intersection wants at least 1 arg(s). got 0")
+	}
+	sets, err := evalSets(env, "intersection", args)
+	if err != nil {
+		return err
+	}
+	result := sets[0]
+	for _, s := range sets[1:] {
+		result = result.Intersection(s)
+	}
+	return result
+}
+
+// Difference returns the elements of the first set which are in none of
+// the others.
+func Difference(env *eval.Frame, args ...object.Value) object.Value {
+	if len(args) == 0 {
+		return object.Error("difference wants at least 1 arg(s). got 0")
+	}
+	sets, err := evalSets(env, "difference", args)
+	if err != nil {
+		return err
+	}
+	result := sets[0]
+	for _, s := range sets[1:] {
+		result = result.Difference(s)
+	}
+	return result
+}
+
+// ListToSet returns a set of the elements of a list.
+func ListToSet(env *eval.Frame, args ...object.Value) object.Value {
+	if err := argsLenError("list->set", args, 1); err != nil {
+		return err
+	}
+	list := eval.Eval(env, args[0])
+	if list.Type() == object.ERROR {
+		return list
+	}
+	s := object.EmptySet
+	for ; list.Type() == object.CELL; list = list.Rest() {
+		var ok bool
+		s, ok = s.Add(list.First())
+		if !ok {
+			return object.Error(fmt.Sprintf("list->set unhashable element: %v", list.First()))
+		}
+	}
+	if list.Type() != object.NIL {
+		return object.Error(fmt.Sprintf("list->set improper list: %v", args[0]))
+	}
+	return s
+}
+
+// SetToList returns a list of the elements of a set in printing order.
+func SetToList(env *eval.Frame, args ...object.Value) object.Value {
+	if err := argsLenError("set->list", args, 1); err != nil {
+		return err
+	}
+	s, err := evalSet(env, "set->list", args[0])
+	if err != nil {
+		return err
+	}
+	elems := s.Sorted()
+	list := object.Value(object.Nil)
+	for i := len(elems) - 1; i >= 0; i-- {
+		list = object.Cell(elems[i], list)
+	}
+	return list
+}
+
+func evalSets(env *eval.Frame, name string, args []object.Value) ([]*object.Set, object.Value) {
+	sets := make([]*object.Set, len(args))
+	for i, a := range args {
+		s, err := evalSet(env, name, a)
+		if err != nil {
+			return nil, err
+		}
+		sets[i] = s
+	}
+	return sets, nil
+}
+
+func evalSet(env *eval.Frame, name string, arg object.Value) (*object.Set, object.Value) {
+	s := eval.Eval(env, arg)
+	if s.Type() == object.ERROR {
+		return nil, s
+	}
+	if s.Type() != object.SET {
+		return nil, object.Error(fmt.Sprintf("%v non-set: %v", name, s))
+	}
+	return s.(*object.Set), nil
+}
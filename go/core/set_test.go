@@ -0,0 +1,63 @@
+package core
+
+import (
+	"testing"
+)
+
+func TestSet(t *testing.T) {
+
+	tests := []coreTest{{
+		input: "#{b a}",
+		want:  "#{a b}",
+	}, {
+		input: "(hash-set 'b 'a (car '(a)))",
+		want:  "#{a b}",
+	}, {
+		input:   "(hash-set (chan))",
+		wantErr: true,
+	}, {
+		input: "(member #{a b} 'a)",
+		want:  "t",
+	}, {
+		input: "(member #{a b} 'c)",
+		want:  "()",
+	}, {
+		input: "(member #{(1 2)} (cons 1 (cons 2 ())))",
+		want:  "t",
+	}, {
+		input:   "(member '(a b) 'a)",
+		wantErr: true,
+	}, {
+		input: "(union #{a} #{b} #{a c})",
+		want:  "#{a b c}",
+	}, {
+		input: "(union)",
+		want:  "#{}",
+	}, {
+		input: "(intersection #{a b c} #{b c d} #{c})",
+		want:  "#{c}",
+	}, {
+		input:   "(intersection)",
+		wantErr: true,
+	}, {
+		input: "(difference #{a b c} #{b} #{c})",
+		want:  "#{a}",
+	}, {
+		input: "(list->set '(b a b a))",
+		want:  "#{a b}",
+	}, {
+		input: "(set->list #{c a b})",
+		want:  "(a b c)",
+	}, {
+		input: "(equal #{a b} (hash-set 'b 'a))",
+		want:  "t",
+	}, {
+		input: "(eq #{a b} #{a})",
+		want:  "()",
+	}, {
+		input: "(get {#{a b} found} #{b a})",
+		want:  "found",
+	}}
+
+	testCore(t, Env, tests)
+}
@@ -0,0 +1,102 @@
+package core
+
+import (
+	"dabble/eval"
+	"dabble/object"
+	"fmt"
+	"sort"
+)
+
+// Compare returns -1, 0 or 1 as its first argument orders before, the same
+// as or after its second in the total order on values.
+func Compare(env *eval.Frame, args ...object.Value) object.Value {
+	if err := argsLenError("compare", args, 2); err != nil {
+		return err
+	}
+	a := eval.Eval(env, args[0])
+	if a.Type() == object.ERROR {
+		return a
+	}
+	b := eval.Eval(env, args[1])
+	if b.Type() == object.ERROR {
+		return b
+	}
+	return object.Number(object.Compare(a, b))
+}
+
+// Sort returns a list or vector sorted in the total order on values, or by
+// an optional function of two arguments which returns non-nil when the
+// first orders before the second. The sort is stable. If the function
+// returns an error sorting stops and the error is returned.
+func Sort(env *eval.Frame, args ...object.Value) object.Value {
+	if len(args) != 1 && len(args) != 2 {
+		return object.Error(fmt.Sprintf("sort wants 1 or 2 arg(s). got %v", len(args)))
+	}
+	seq := eval.Eval(env, args[0])
+	if seq.Type() == object.ERROR {
+		return seq
+	}
+	var elems []object.Value
+	switch seq.Type() {
+	case object.VECTOR:
+		elems = seq.(*object.Vector).Elements()
+	case object.CELL, object.NIL:
+		for l := seq; l.Type() == object.CELL; l = l.Rest() {
+			elems = append(elems, l.First())
+		}
+	default:
+		return object.Error(fmt.Sprintf("sort non-sequence: %v", seq))
+	}
+
+	less := func(a, b object.Value) (bool, object.Value) {
+		return object.Compare(a, b) < 0, nil
+	}
+	if len(args) == 2 {
+		function := eval.Eval(env, args[1])
+		if function.Type() == object.ERROR {
+			return function
+		}
+		if function.Type() != object.FUNCTION {
+			return object.Error(fmt.Sprintf("sort non-function: %v", function))
+		}
+		fn := function.(*eval.Function)
+		less = func(a, b object.Value) (bool, object.Value) {
+			la, ok := literal(a)
+			if !ok {
+				return false, object.Error(fmt.Sprintf("sort cannot pass %v", a))
+			}
+			lb, ok := literal(b)
+			if !ok {
+				return false, object.Error(fmt.Sprintf("sort cannot pass %v", b))
+			}
+			r := fn.Fn(env, la, lb)
+			if r.Type() == object.ERROR {
+				return false, r
+			}
+			return r.Type() != object.NIL, nil
+		}
+	}
+
+	var err object.Value
+	sort.SliceStable(elems, func(i, j int) bool {
+		if err != nil {
+			return false
+		}
+		ok, e := less(elems[i], elems[j])
+		if e != nil {
+			err = e
+		}
+		return ok
+	})
+	if err != nil {
+		return err
+	}
+	if seq.Type() == object.VECTOR {
+		return object.NewVector(elems)
+	}
+	var list object.Value = object.Nil
+	for i := len(elems) - 1; i >= 0; i-- {
+		list = object.Cell(elems[i], list)
+	}
+	return list
+}
@@ -0,0 +1,73 @@
+package core
+
+import (
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+
+	tests := []coreTest{{
+		input: "(compare 1 2)",
+		want:  "-1",
+	}, {
+		input: "(compare 'b 'a)",
+		want:  "1",
+	}, {
+		input: "(compare '(1 2) '(1 2))",
+		want:  "0",
+	}, {
+		input: "(compare '(1 2) '(1 3))",
+		want:  "-1",
+	}, {
+		input: "(compare 1/2 0.5)",
+		want:  "-1",
+	}, {
+		input: "(compare 100 'a)",
+		want:  "-1",
+	}, {
+		input: "(compare 'a '(a))",
+		want:  "-1",
+	}, {
+		input:   "(compare 1)",
+		wantErr: true,
+	}}
+
+	testCore(t, Env, tests)
+}
+
+func TestSort(t *testing.T) {
+
+	tests := []coreTest{{
+		input: "(sort ())",
+		want:  "()",
+	}, {
+		input: "(sort '(3 1 2))",
+		want:  "(1 2 3)",
+	}, {
+		input: "(sort '((b) a 2 (a b) (a) 1/2))",
+		want:  "(1/2 2 a (a) (a b) (b))",
+	}, {
+		input: "(sort [c a b])",
+		want:  "[a b c]",
+	}, {
+		input: "(sort '(1 3 2) (lambda (a b) (< b a)))",
+		want:  "(3 2 1)",
+	}, {
+		input: "(sort '((1 a) (0 b) (1 c) (0 d)) (lambda (a b) (< (car a) (car b))))",
+		want:  "((0 b) (0 d) (1 a) (1 c))",
+	}, {
+		input:   "(sort '(1 a) (lambda (a b) (< a b)))",
+		wantErr: true,
+	}, {
+		input:   "(sort '(1 2) 'a)",
+		wantErr: true,
+	}, {
+		input:   "(sort 1)",
+		wantErr: true,
+	}, {
+		input: "(sort)",
+		want:  "<error: sort wants 1 or 2 arg(s). got 0>",
+	}}
+
+	testCore(t, Env, tests)
+}
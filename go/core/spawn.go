@@ -0,0 +1,45 @@
+package core
+
+import (
+	"dabble/eval"
+	"dabble/object"
+	"fmt"
+)
+
+// Spawn calls a function of no arguments on a new goroutine and returns a
+// future of its result.
+func Spawn(env *eval.Frame, args ...object.Value) object.Value {
+	if err := argsLenError("spawn", args, 1); err != nil {
+		return err
+	}
+	function := eval.Eval(env, args[0])
+	if function.Type() == object.ERROR {
+		return function
+	}
+	if function.Type() != object.FUNCTION {
+		return object.Error(fmt.Sprintf("spawn non-function: %v", function))
+	}
+	env = env.Detached()
+	return object.Go(func() object.Value {
+		return function.(*eval.Function).Fn(env)
+	})
+}
+
+// Await waits for a future or an actor and returns its result.
+func Await(env *eval.Frame, args ...object.Value) object.Value {
+	if err := argsLenError("await", args, 1); err != nil {
+		return err
+	}
+	future := eval.Eval(env, args[0])
+	if future.Type() == object.ERROR {
+		return future
+	}
+	switch future.Type() {
+	case object.FUTURE:
+		return future.(*object.Future).Await()
+	case object.ACTOR:
+		return future.(*object.Actor).Await()
+	default:
+		return object.Error(fmt.Sprintf("await non-future: %v", future))
+	}
+}
@@ -0,0 +1,37 @@
+package core
+
+import (
+	"testing"
+)
+
+func TestSpawn(t *testing.T) {
+
+	tests := []coreTest{{
+		input: "(await (spawn (lambda () (car '(1 2)))))",
+		want:  "1",
+	}, {
+		input: "(label f (spawn (lambda () (cons 1 ()))) (cons (await f) (cons (await f) ())))",
+		want:  "((1) (1))",
+	}, {
+		input: `
+(label f (lambda (x) (cons x x))
+  (label a (spawn (lambda () (f 1)))
+    (label b (spawn (lambda () (f 2)))
+      (cons (await a) (cons (await b) ())))))`,
+		want: "((1 1) (2 2))",
+	}, {
+		input:   "(await (spawn (lambda () (error oops))))",
+		wantErr: true,
+	}, {
+		input:   "(spawn 1)",
+		wantErr: true,
+	}, {
+		input:   "(await (spawn (lambda (x) x)))",
+		wantErr: true,
+	}, {
+		input:   "(await 1)",
+		wantErr: true,
+	}}
+
+	testCore(t, Env, tests)
+}
@@ -0,0 +1,47 @@
+package core
+
+import (
+	"dabble/eval"
+	"dabble/object"
+)
+
+// Delay returns a promise to evaluate its argument in the current
+// environment when forced.
+func Delay(env *eval.Frame, args ...object.Value) object.Value {
+	if err := argsLenError("delay", args, 1); err != nil {
+		return err
+	}
+	return delay(env, args[0])
+}
+
+// Force evaluates a promise, or returns any other value as it is.
+func Force(env *eval.Frame, args ...object.Value) object.Value {
+	if err := argsLenError("force", args, 1); err != nil {
+		return err
+	}
+	value := eval.Eval(env, args[0])
+	if value.Type() != object.PROMISE {
+		return value
+	}
+	return value.(*object.Promise).Force()
+}
+
+// ConsStream is cons with a delayed rest. Since cdr forces a promise in
+// the rest, a stream can be walked like a list and ends with ().
+func ConsStream(env *eval.Frame, args ...object.Value) object.Value {
+	if err := argsLenError("cons-stream", args, 2); err != nil {
+		return err
+	}
+	car := eval.Eval(env, args[0])
+	if car.Type() == object.ERROR {
+		return car
+	}
+	return object.Cell(car, delay(env, args[1]))
+}
+
+func delay(env *eval.Frame, form object.Value) *object.Promise {
+	return object.Delay(func() object.Value {
+		env.T("forcing %v", form)
+		return eval.Eval(env, form)
+	})
+}
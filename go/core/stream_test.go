@@ -0,0 +1,83 @@
+package core
+
+import (
+	"dabble/eval"
+	"dabble/object"
+	"testing"
+)
+
+func TestStream(t *testing.T) {
+
+	env := Env.Bind("+", &eval.Function{Fn: func(env *eval.Frame, args ...object.Value) object.Value {
+		a := eval.Eval(env, args[0])
+		b := eval.Eval(env, args[1])
+		return object.Number(a.(object.Number) + b.(object.Number))
+	}})
+
+	tests := []coreTest{{
+		input: "(delay (car '(1 2)))",
+		want:  "<promise>",
+	}, {
+		input: "(force (delay (car '(1 2))))",
+		want:  "1",
+	}, {
+		input: "(force 1)",
+		want:  "1",
+	}, {
+		input: "(label p (delay (cons 1 ())) (cons (force p) (cons p ())))",
+		want:  "((1) <promise (1)>)",
+	}, {
+		input: "(delay (error oops))",
+		want:  "<promise>",
+	}, {
+		input:   "(force (delay (error oops)))",
+		wantErr: true,
+	}, {
+		input: "(cons-stream 1 (error oops))",
+		want:  "(1 <promise>)",
+	}, {
+		input:   "(cdr (cons-stream 1 (error oops)))",
+		wantErr: true,
+	}, {
+		input: "(eq () (cdr (cons-stream 1 ())))",
+		want:  "t",
+	}, {
+		input: "(atom (cdr (cons-stream 1 (cons-stream 2 ()))))",
+		want:  "()",
+	}, {
+		input: "(equal (cons-stream 1 (cons-stream 2 ())) '(1 2))",
+		want:  "t",
+	}, {
+		input: "(equal '(1 2) (cons-stream 1 (cons-stream 2 ())))",
+		want:  "t",
+	}, {
+		input: "(equal (cons-stream 1 ()) '(1 2))",
+		want:  "()",
+	}, {
+		input: `
+(label count
+  (lambda (s n) (if (eq () s) n (recur (cdr s) (+ n 1))))
+  (count (cons-stream 'a (cons-stream 'b (cons-stream 'c ()))) 0))`,
+		want: "3",
+	}, {
+		input: "(car (cons-stream 1 (error oops)))",
+		want:  "1",
+	}, {
+		input: "(car (cdr (cons-stream 1 (cons-stream 2 ()))))",
+		want:  "2",
+	}, {
+		input: `
+(label integers
+  ((lambda (n) (cons-stream n (recur (+ n 1)))) 0)
+  (car (cdr (cdr (cdr integers)))))`,
+		want: "3",
+	}, {
+		input:   "(delay)",
+		wantErr: true,
+	}, {
+		input:   "(cons-stream 1)",
+		wantErr: true,
+	}}
+
+	testCore(t, env, tests)
+}
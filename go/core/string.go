@@ -0,0 +1,108 @@
+package core
+
+import (
+	"dabble/eval"
+	"dabble/object"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// StringLength returns the number of characters in a string.
+func StringLength(env *eval.Frame, args ...object.Value) object.Value {
+	if err := argsLenError("string-length", args, 1); err != nil {
+		return err
+	}
+	s, err := evalString(env, "string-length", args[0])
+	if err != nil {
+		return err
+	}
+	return object.Number(utf8.RuneCountInString(string(s)))
+}
+
+// Substring returns the characters of a string from start up to end.
+// (substring s start) runs to the end of s.
+func Substring(env *eval.Frame, args ...object.Value) object.Value {
+	if len(args) != 2 && len(args) != 3 {
+		return object.Error(fmt.Sprintf("substring wants 2 or 3 arg(s). got %v", len(args)))
+	}
+	s, err := evalString(env, "substring", args[0])
+	if err != nil {
+		return err
+	}
+	runes := []rune(string(s))
+	bounds := []int{0, len(runes)}
+	for i, a := range args[1:] {
+		n := eval.Eval(env, a)
+		if n.Type() == object.ERROR {
+			return n
+		}
+		if n.Type() != object.NUMBER {
+			return object.Error(fmt.Sprintf("substring non-number index: %v", n))
+		}
+		bounds[i] = int(n.(object.Number))
+	}
+	start, end := bounds[0], bounds[1]
+	if start < 0 || end > len(runes) || start > end {
+		return object.Error(fmt.Sprintf("substring %v to %v out of range for %v", start, end, s))
+	}
+	return object.String(runes[start:end])
+}
+
+// StringAppend concatenates any number of strings.
+func StringAppend(env *eval.Frame, args ...object.Value) object.Value {
+	var b strings.Builder
+	for _, a := range args {
+		s, err := evalString(env, "string-append", a)
+		if err != nil {
+			return err
+		}
+		b.WriteString(string(s))
+	}
+	return object.String(b.String())
+}
+
+// SymbolToString returns the name of a symbol as a string.
+func SymbolToString(env *eval.Frame, args ...object.Value) object.Value {
+	if err := argsLenError("symbol->string", args, 1); err != nil {
+		return err
+	}
+	s := eval.Eval(env, args[0])
+	switch s.Type() {
+	case object.ERROR:
+		return s
+	case object.SYMBOL:
+		return object.String(s.(object.Symbol))
+	case object.NIL:
+		// The empty symbol reads as ().
+		return object.String("")
+	default:
+		return object.Error(fmt.Sprintf("symbol->string non-symbol: %v", s))
+	}
+}
+
+// StringToSymbol returns the symbol named by a string.
+func StringToSymbol(env *eval.Frame, args ...object.Value) object.Value {
+	if err := argsLenError("string->symbol", args, 1); err != nil {
+		return err
+	}
+	s, err := evalString(env, "string->symbol", args[0])
+	if err != nil {
+		return err
+	}
+	if s == "" {
+		return object.Nil
+	}
+	return object.Symbol(s)
+}
+
+func evalString(env *eval.Frame, name string, arg object.Value) (object.String, object.Value) {
+	s := eval.Eval(env, arg)
+	if s.Type() == object.ERROR {
+		return "", s
+	}
+	if s.Type() != object.STRING {
+		return "", object.Error(fmt.Sprintf("%v non-string: %v", name, s))
+	}
+	return s.(object.String), nil
+}
@@ -0,0 +1,81 @@
+package core
+
+import (
+	"testing"
+)
+
+func TestString(t *testing.T) {
+
+	tests := []coreTest{{
+		input: `"hello world"`,
+		want:  `"hello world"`,
+	}, {
+		input: `(eq "a" "a")`,
+		want:  "t",
+	}, {
+		input: `(eq "a" 'a)`,
+		want:  "()",
+	}, {
+		input: `(car "héllo")`,
+		want:  `#\h`,
+	}, {
+		input: `(cdr "héllo")`,
+		want:  `"éllo"`,
+	}, {
+		input: `(string-length "héllo")`,
+		want:  "5",
+	}, {
+		input: `(string-length "")`,
+		want:  "0",
+	}, {
+		input: `(substring "héllo" 1 3)`,
+		want:  `"él"`,
+	}, {
+		input: `(substring "héllo" 2)`,
+		want:  `"llo"`,
+	}, {
+		input:   `(substring "abc" 2 1)`,
+		wantErr: true,
+	}, {
+		input:   `(substring "abc" 0 4)`,
+		wantErr: true,
+	}, {
+		input:   `(substring "abc" 'a)`,
+		wantErr: true,
+	}, {
+		input: `(string-append "foo" " " "bar\n")`,
+		want:  `"foo bar\n"`,
+	}, {
+		input: `(string-append)`,
+		want:  `""`,
+	}, {
+		input:   `(string-append "a" 'b)`,
+		wantErr: true,
+	}, {
+		input: `(symbol->string 'foo)`,
+		want:  `"foo"`,
+	}, {
+		input: `(symbol->string ())`,
+		want:  `""`,
+	}, {
+		input:   `(symbol->string "foo")`,
+		wantErr: true,
+	}, {
+		input: `(string->symbol "foo bar")`,
+		want:  "foo bar",
+	}, {
+		input: `(eq (string->symbol "foo") 'foo)`,
+		want:  "t",
+	}, {
+		input: `(string->symbol "")`,
+		want:  "()",
+	}, {
+		input:   `(string->symbol 'foo)`,
+		wantErr: true,
+	}, {
+		input:   `(error "something went wrong")`,
+		wantErr: true,
+	}}
+
+	testCore(t, Env, tests)
+}
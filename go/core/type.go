@@ -0,0 +1,165 @@
+package core
+
+import (
+	"dabble/eval"
+	"dabble/object"
+	"strings"
+)
+
+// TypeOf returns the type of a value as a symbol: number, bigint, ratio,
+// float, symbol, string, char, bytes, cell, nil, vector, map, set,
+// function, error, promise, future, channel or actor. Records and
+// registered types return their type name. An error argument is returned
+// as type error rather than propagated.
+func TypeOf(env *eval.Frame, args ...object.Value) object.Value {
+	if err := argsLenError("type-of", args, 1); err != nil {
+		return err
+	}
+	value := eval.Eval(env, args[0])
+	if object.IsBuiltinType(value.Type()) {
+		return object.Symbol(strings.ToLower(string(value.Type())))
+	}
+	return object.Symbol(value.Type())
+}
+
+// IsError returns t if a value is an error. Unlike other builtins it
+// doesn't propagate an error argument, so errors can be tested for.
+func IsError(env *eval.Frame, args ...object.Value) object.Value {
+	if err := argsLenError("error?", args, 1); err != nil {
+		return err
+	}
+	return truth(eval.Eval(env, args[0]).Type() == object.ERROR)
+}
+
+// IsNumber returns t if a value is a number of any kind.
+func IsNumber(env *eval.Frame, args ...object.Value) object.Value {
+	return typeIs(env, "number?", object.IsNumber, args)
+}
+
+// IsInteger returns t if a value is an integer.
+func IsInteger(env *eval.Frame, args ...object.Value) object.Value {
+	return typeIs(env, "integer?", object.IsInteger, args)
+}
+
+// IsRational returns t if a value is an exact number, an integer or a
+// ratio.
+func IsRational(env *eval.Frame, args ...object.Value) object.Value {
+	return typeIs(env, "rational?", object.IsExact, args)
+}
+
+// IsFloat returns t if a value is a float.
+func IsFloat(env *eval.Frame, args ...object.Value) object.Value {
+	return typeIs(env, "float?", hasType(object.FLOAT), args)
+}
+
+// IsSymbol returns t if a value is a symbol.
+func IsSymbol(env *eval.Frame, args ...object.Value) object.Value {
+	return typeIs(env, "symbol?", hasType(object.SYMBOL), args)
+}
+
+// IsString returns t if a value is a string.
+func IsString(env *eval.Frame, args ...object.Value) object.Value {
+	return typeIs(env, "string?", hasType(object.STRING), args)
+}
+
+// IsChar returns t if a value is a character.
+func IsChar(env *eval.Frame, args ...object.Value) object.Value {
+	return typeIs(env, "char?", hasType(object.CHAR), args)
+}
+
+// IsBytes returns t if a value is a byte array.
+func IsBytes(env *eval.Frame, args ...object.Value) object.Value {
+	return typeIs(env, "bytes?", hasType(object.BYTES), args)
+}
+
+// IsCons returns t if a value is a cell.
+func IsCons(env *eval.Frame, args ...object.Value) object.Value {
+	return typeIs(env, "cons?", hasType(object.CELL), args)
+}
+
+// IsNull returns t if a value is ().
+func IsNull(env *eval.Frame, args ...object.Value) object.Value {
+	return typeIs(env, "null?", hasType(object.NIL), args)
+}
+
+// IsList returns t if a value is () or a cell.
+func IsList(env *eval.Frame, args ...object.Value) object.Value {
+	return typeIs(env, "list?", hasType(object.NIL, object.CELL), args)
+}
+
+// IsVector returns t if a value is a vector.
+func IsVector(env *eval.Frame, args ...object.Value) object.Value {
+	return typeIs(env, "vector?", hasType(object.VECTOR), args)
+}
+
+// IsMap returns t if a value is a map.
+func IsMap(env *eval.Frame, args ...object.Value) object.Value {
+	return typeIs(env, "map?", hasType(object.MAP), args)
+}
+
+// IsSet returns t if a value is a set.
+func IsSet(env *eval.Frame, args ...object.Value) object.Value {
+	return typeIs(env, "set?", hasType(object.SET), args)
+}
+
+// IsRecord returns t if a value is a record of any record type.
+func IsRecord(env *eval.Frame, args ...object.Value) object.Value {
+	return typeIs(env, "record?", func(v object.Value) bool {
+		_, ok := v.(*object.Record)
+		return ok
+	}, args)
+}
+
+// IsFunction returns t if a value is a function.
+func IsFunction(env *eval.Frame, args ...object.Value) object.Value {
+	return typeIs(env, "function?", hasType(object.FUNCTION), args)
+}
+
+// IsPromise returns t if a value is a promise.
+func IsPromise(env *eval.Frame, args ...object.Value) object.Value {
+	return typeIs(env, "promise?", hasType(object.PROMISE), args)
+}
+
+// IsFuture returns t if a value is a future.
+func IsFuture(env *eval.Frame, args ...object.Value) object.Value {
+	return typeIs(env, "future?", hasType(object.FUTURE), args)
+}
+
+// IsChannel returns t if a value is a channel.
+func IsChannel(env *eval.Frame, args ...object.Value) object.Value {
+	return typeIs(env, "channel?", hasType(object.CHANNEL), args)
+}
+
+// IsActor returns t if a value is an actor.
+func IsActor(env *eval.Frame, args ...object.Value) object.Value {
+	return typeIs(env, "actor?", hasType(object.ACTOR), args)
+}
+
+func typeIs(env *eval.Frame, name string, is func(object.Value) bool, args []object.Value) object.Value {
+	if err := argsLenError(name, args, 1); err != nil {
+		return err
+	}
+	value := eval.Eval(env, args[0])
+	if value.Type() == object.ERROR {
+		return value
+	}
+	return truth(is(value))
+}
+
+func hasType(types ...object.Type) func(object.Value) bool {
+	return func(v object.Value) bool {
+		for _, t := range types {
+			if v.Type() == t {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func truth(b bool) object.Value {
+	if b {
+		return object.Symbol("t")
+	}
+	return object.Nil
+}
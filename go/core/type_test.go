@@ -0,0 +1,175 @@
+package core
+
+import (
+	"testing"
+)
+
+func TestTypeOf(t *testing.T) {
+
+	tests := []coreTest{{
+		input: "(type-of 1)",
+		want:  "number",
+	}, {
+		input: "(type-of 100000000000000000000)",
+		want:  "bigint",
+	}, {
+		input: "(type-of 1/2)",
+		want:  "ratio",
+	}, {
+		input: "(type-of 0.5)",
+		want:  "float",
+	}, {
+		input: "(type-of 'a)",
+		want:  "symbol",
+	}, {
+		input: `(type-of "a")`,
+		want:  "string",
+	}, {
+		input: `(type-of #\a)`,
+		want:  "char",
+	}, {
+		input: "(type-of '(a))",
+		want:  "cell",
+	}, {
+		input: "(type-of ())",
+		want:  "nil",
+	}, {
+		input: "(type-of [])",
+		want:  "vector",
+	}, {
+		input: "(type-of {})",
+		want:  "map",
+	}, {
+		input: "(type-of car)",
+		want:  "function",
+	}, {
+		input: "(type-of (chan))",
+		want:  "channel",
+	}, {
+		input: "(type-of (error boom))",
+		want:  "error",
+	}, {
+		input: "(type-of (vector-ref [] 0))",
+		want:  "error",
+	}, {
+		input: "(defrecord point (x y) (type-of (make-point 1 2)))",
+		want:  "point",
+	}, {
+		input:   "(type-of)",
+		wantErr: true,
+	}}
+
+	testCore(t, Env, tests)
+}
+
+func TestTypePredicates(t *testing.T) {
+
+	tests := []coreTest{{
+		input: "(error? (error boom))",
+		want:  "t",
+	}, {
+		input: "(error? (vector-ref [] 0))",
+		want:  "t",
+	}, {
+		input: "(error? 1)",
+		want:  "()",
+	}, {
+		input: "(if (error? (vector-ref [] 0)) 'failed 'ok)",
+		want:  "failed",
+	}, {
+		input: "(number? 1.5)",
+		want:  "t",
+	}, {
+		input: "(number? 'a)",
+		want:  "()",
+	}, {
+		input: "(integer? 100000000000000000000)",
+		want:  "t",
+	}, {
+		input: "(integer? 1/2)",
+		want:  "()",
+	}, {
+		input: "(rational? 1/2)",
+		want:  "t",
+	}, {
+		input: "(rational? 0.5)",
+		want:  "()",
+	}, {
+		input: "(float? 0.5)",
+		want:  "t",
+	}, {
+		input: "(symbol? 'a)",
+		want:  "t",
+	}, {
+		input: `(symbol? "a")`,
+		want:  "()",
+	}, {
+		input: `(string? "a")`,
+		want:  "t",
+	}, {
+		input: `(char? #\a)`,
+		want:  "t",
+	}, {
+		input: "(bytes? #u8(1))",
+		want:  "t",
+	}, {
+		input: "(cons? '(a))",
+		want:  "t",
+	}, {
+		input: "(cons? ())",
+		want:  "()",
+	}, {
+		input: "(null? ())",
+		want:  "t",
+	}, {
+		input: "(list? ())",
+		want:  "t",
+	}, {
+		input: "(list? '(a))",
+		want:  "t",
+	}, {
+		input: "(list? [a])",
+		want:  "()",
+	}, {
+		input: "(vector? [a])",
+		want:  "t",
+	}, {
+		input: "(map? {a 1})",
+		want:  "t",
+	}, {
+		input: "(set? #{a})",
+		want:  "t",
+	}, {
+		input: "(defrecord point (x y) (record? (make-point 1 2)))",
+		want:  "t",
+	}, {
+		input: "(record? {x 1})",
+		want:  "()",
+	}, {
+		input: "(function? (lambda (x) x))",
+		want:  "t",
+	}, {
+		input: "(function? 'car)",
+		want:  "()",
+	}, {
+		input: "(promise? (delay 1))",
+		want:  "t",
+	}, {
+		input: "(future? (spawn (lambda () 1)))",
+		want:  "t",
+	}, {
+		input: "(channel? (chan))",
+		want:  "t",
+	}, {
+		input: "(actor? (spawn-actor 1))",
+		want:  "t",
+	}, {
+		input:   "(number? (vector-ref [] 0))",
+		wantErr: true,
+	}, {
+		input:   "(number? 1 2)",
+		wantErr: true,
+	}}
+
+	testCore(t, Env, tests)
+}
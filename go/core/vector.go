@@ -0,0 +1,126 @@
+package core
+
+import (
+	"dabble/eval"
+	"dabble/object"
+	"fmt"
+)
+
+// Vector returns a vector of its arguments.
+func Vector(env *eval.Frame, args ...object.Value) object.Value {
+	elems := make([]object.Value, len(args))
+	for i, a := range args {
+		e := eval.Eval(env, a)
+		if e.Type() == object.ERROR {
+			return e
+		}
+		elems[i] = e
+	}
+	return object.NewVector(elems)
+}
+
+// VectorRef returns the element of a vector at an index.
+func VectorRef(env *eval.Frame, args ...object.Value) object.Value {
+	if err := argsLenError("vector-ref", args, 2); err != nil {
+		return err
+	}
+	v, i, err := evalVectorIndex(env, "vector-ref", args[0], args[1])
+	if err != nil {
+		return err
+	}
+	return v.Ref(i)
+}
+
+// VectorLength returns the number of elements in a vector.
+func VectorLength(env *eval.Frame, args ...object.Value) object.Value {
+	if err := argsLenError("vector-length", args, 1); err != nil {
+		return err
+	}
+	v, err := evalVector(env, "vector-length", args[0])
+	if err != nil {
+		return err
+	}
+	return object.Number(v.Len())
+}
+
+// VectorSet returns a new vector with the element at an index replaced.
+// The original vector is unchanged.
+func VectorSet(env *eval.Frame, args ...object.Value) object.Value {
+	if err := argsLenError("vector-set", args, 3); err != nil {
+		return err
+	}
+	v, i, err := evalVectorIndex(env, "vector-set", args[0], args[1])
+	if err != nil {
+		return err
+	}
+	e := eval.Eval(env, args[2])
+	if e.Type() == object.ERROR {
+		return e
+	}
+	return v.Set(i, e)
+}
+
+// ListToVector returns a vector of the elements of a list.
+func ListToVector(env *eval.Frame, args ...object.Value) object.Value {
+	if err := argsLenError("list->vector", args, 1); err != nil {
+		return err
+	}
+	list := eval.Eval(env, args[0])
+	if list.Type() == object.ERROR {
+		return list
+	}
+	var elems []object.Value
+	for ; list.Type() == object.CELL; list = list.Rest() {
+		elems = append(elems, list.First())
+	}
+	if list.Type() != object.NIL {
+		return object.Error(fmt.Sprintf("list->vector improper list: %v", args[0]))
+	}
+	return object.NewVector(elems)
+}
+
+// VectorToList returns a list of the elements of a vector.
+func VectorToList(env *eval.Frame, args ...object.Value) object.Value {
+	if err := argsLenError("vector->list", args, 1); err != nil {
+		return err
+	}
+	v, err := evalVector(env, "vector->list", args[0])
+	if err != nil {
+		return err
+	}
+	list := object.Value(object.Nil)
+	for i := v.Len() - 1; i >= 0; i-- {
+		list = object.Cell(v.Ref(i), list)
+	}
+	return list
+}
+
+func evalVector(env *eval.Frame, name string, arg object.Value) (*object.Vector, object.Value) {
+	v := eval.Eval(env, arg)
+	if v.Type() == object.ERROR {
+		return nil, v
+	}
+	if v.Type() != object.VECTOR {
+		return nil, object.Error(fmt.Sprintf("%v non-vector: %v", name, v))
+	}
+	return v.(*object.Vector), nil
+}
+
+func evalVectorIndex(env *eval.Frame, name string, vector, index object.Value) (*object.Vector, int, object.Value) {
+	v, err := evalVector(env, name, vector)
+	if err != nil {
+		return nil, 0, err
+	}
+	n := eval.Eval(env, index)
+	if n.Type() == object.ERROR {
+		return nil, 0, n
+	}
+	if n.Type() != object.NUMBER {
+		return nil, 0, object.Error(fmt.Sprintf("%v non-number index: %v", name, n))
+	}
+	i := n.(object.Number)
+	if i < 0 || int64(i) >= int64(v.Len()) {
+		return nil, 0, object.Error(fmt.Sprintf("%v index %v out of range for %v", name, i, v))
+	}
+	return v, int(i), nil
+}
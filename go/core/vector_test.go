@@ -0,0 +1,78 @@
+package core
+
+import (
+	"testing"
+)
+
+func TestVector(t *testing.T) {
+
+	tests := []coreTest{{
+		input: "[1 2 3]",
+		want:  "[1 2 3]",
+	}, {
+		input: "[a (b c)]",
+		want:  "[a (b c)]",
+	}, {
+		input: "(vector 1 (+ 1 1) 'c)",
+		want:  "[1 2 c]",
+	}, {
+		input: "(vector)",
+		want:  "[]",
+	}, {
+		input: "(vector-ref [a b c] 1)",
+		want:  "b",
+	}, {
+		input:   "(vector-ref [a b c] 3)",
+		wantErr: true,
+	}, {
+		input:   "(vector-ref [a b c] -1)",
+		wantErr: true,
+	}, {
+		input:   "(vector-ref '(a b c) 0)",
+		wantErr: true,
+	}, {
+		input: "(vector-length [])",
+		want:  "0",
+	}, {
+		input: "(vector-length [1 [2 3]])",
+		want:  "2",
+	}, {
+		input: "(vector-set [1 2 3] 0 'x)",
+		want:  "[x 2 3]",
+	}, {
+		input: "((lambda (v) (cons (vector-set v 0 'x) (cons v ()))) [1 2])",
+		want:  "([x 2] [1 2])",
+	}, {
+		input: "(list->vector '(1 2 3))",
+		want:  "[1 2 3]",
+	}, {
+		input: "(list->vector ())",
+		want:  "[]",
+	}, {
+		input:   "(list->vector '(1 . 2))",
+		wantErr: true,
+	}, {
+		input: "(vector->list [1 2 3])",
+		want:  "(1 2 3)",
+	}, {
+		input: "(vector->list [])",
+		want:  "()",
+	}, {
+		input: "(car [1 2 3])",
+		want:  "1",
+	}, {
+		input: "(cdr [1 2 3])",
+		want:  "[2 3]",
+	}, {
+		input: "(equal [1 (2) [3]] (vector 1 '(2) [3]))",
+		want:  "t",
+	}, {
+		input: "(eq [1 2] [1 2 3])",
+		want:  "()",
+	}, {
+		input: "(eq [1 2] '(1 2))",
+		want:  "()",
+	}}
+
+	testCore(t, Env, tests)
+}
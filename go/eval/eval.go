@@ -10,72 +10,83 @@ func Eval(env *Frame, value object.Value) object.Value {
 }
 
 func eval(env *Frame, quoted bool, value object.Value) (ret object.Value) {
+	if env.Cancelled() {
+		return object.Error("cancelled")
+	}
+	t := env.trace()
 	t.In()
 	defer t.Out()
 	defer func() {
-		T("returning %v", ret)
+		t.T("returning %v", ret)
 	}()
 	switch value.Type() {
-	case object.NUMBER, object.FUNCTION, object.NIL, object.ERROR:
-		T("self evaluation of %v", value)
+	case object.NUMBER, object.BIGINT, object.RATIO, object.FLOAT, object.STRING, object.CHAR, object.BYTES,
+		object.VECTOR, object.MAP, object.SET, object.FUNCTION, object.NIL, object.ERROR, object.PROMISE, object.FUTURE,
+		object.CHANNEL, object.ACTOR:
+		t.T("self evaluation of %v", value)
 		return value
 	case object.SYMBOL:
 		if quoted {
-			T("quoted symbol %v", value)
+			t.T("quoted symbol %v", value)
 			return value
 		} else {
 			r := env.Resolve(value.(object.Symbol))
 			if r.Type() == object.ERROR {
-				T("error resolving symbol %v in environment %v", value, env)
+				t.T("error resolving symbol %v in environment %v", value, env)
 				return r
 			}
-			T("resolved symbol %v to %v", value, r)
+			t.T("resolved symbol %v to %v", value, r)
 			return r
 		}
 	case object.CELL:
 		if quoted {
-			T("eval first %v", value.First())
+			t.T("eval first %v", value.First())
 			first := eval(env, quoted, value.First())
 			if first.Type() == object.ERROR {
 				return first
 			}
-			T("eval rest %v", value.Rest())
+			t.T("eval rest %v", value.Rest())
 			rest := eval(env, quoted, value.Rest())
 			if rest.Type() == object.ERROR {
 				return rest
 			}
 			return object.Cell(first, rest)
 		} else {
-			T("calling %v", value)
+			t.T("calling %v", value)
 			return call(env, quoted, value)
 		}
 	case object.QUOTED:
 		if quoted {
-			T("looking for unquotes in quoted value")
+			t.T("looking for unquotes in quoted value")
 			q := eval(env, true, value.First())
 			if q.Type() == object.ERROR {
 				return q
 			}
 			return object.Quoted(q)
 		} else {
-			T("unwrapping quoted %v", value)
+			t.T("unwrapping quoted %v", value)
 			return eval(env, true, value.First())
 		}
 	case object.UNQUOTED:
-		T("evaluating within unquoted %v", value)
+		t.T("evaluating within unquoted %v", value)
 		return eval(env, false, value.First())
 	default:
+		if object.IsSelfEvaluating(value) {
+			t.T("self evaluation of %v", value)
+			return value
+		}
 		return object.Error(fmt.Sprintf("eval: unknown type: %T", value))
 	}
 }
 
 func call(env *Frame, quoted bool, cell object.Value) (ret object.Value) {
+	t := env.trace()
 	t.In()
 	defer t.Out()
 	defer func() {
-		T("returning %v", ret)
+		t.T("returning %v", ret)
 	}()
-	T("evaluting %v", cell.First())
+	t.T("evaluting %v", cell.First())
 	first := eval(env, quoted, cell.First())
 	if first.Type() == object.ERROR {
 		return first
@@ -90,7 +101,7 @@ func call(env *Frame, quoted bool, cell object.Value) (ret object.Value) {
 		rest = rest.Rest()
 	}
 
-	T("calling %v with args %v", first, cell.Rest())
+	t.T("calling %v with args %v", first, cell.Rest())
 	function := first.(*Function)
 	return function.Fn(env, args...)
 }
@@ -100,3 +100,39 @@ func TestEval(t *testing.T) {
 		})
 	}
 }
+
+// handle is a Go value registered by an embedder.
+type handle struct{ name string }
+
+func (h *handle) First() object.Value { return object.Nil }
+func (h *handle) Rest() object.Value  { return object.Nil }
+func (h *handle) Type() object.Type   { return "test-handle" }
+func (h *handle) String() string      { return h.name }
+
+type opaque struct{}
+
+func (o opaque) First() object.Value { return object.Nil }
+func (o opaque) Rest() object.Value  { return object.Nil }
+func (o opaque) Type() object.Type   { return "test-opaque" }
+func (o opaque) String() string      { return "opaque" }
+
+// The registry can't unregister types, so they are registered once for
+// every run of the tests.
+func init() {
+	if err := object.RegisterType("test-handle", object.TypeInfo{SelfEvaluating: true}); err != nil {
+		panic(err)
+	}
+	if err := object.RegisterType("test-opaque", object.TypeInfo{}); err != nil {
+		panic(err)
+	}
+}
+
+func TestEvalRegisteredType(t *testing.T) {
+	h := &handle{"db"}
+	if got := Eval(nil, h); got != h {
+		t.Errorf("want a self evaluating handle. got %v", got)
+	}
+	if got := Eval(nil, opaque{}); got.Type() != object.ERROR {
+		t.Errorf("want an error evaluating a type which is not self evaluating. got %v", got)
+	}
+}
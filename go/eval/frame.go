@@ -13,6 +13,7 @@ type Frame struct {
 	symbol object.Symbol
 	value  object.Value
 	next   *Frame
+	ctx    *context
 }
 
 func (f *Frame) Bind(symbol object.Symbol, value object.Value) *Frame {
@@ -20,6 +21,7 @@ func (f *Frame) Bind(symbol object.Symbol, value object.Value) *Frame {
 		symbol: symbol,
 		value:  value,
 		next:   f,
+		ctx:    f.context(),
 	}
 }
 
@@ -37,6 +39,7 @@ func (f *Frame) Call(caller *Function) *Frame {
 	return &Frame{
 		caller: caller,
 		next:   f,
+		ctx:    f.context(),
 	}
 }
 
@@ -54,10 +57,10 @@ func (f *Frame) LastCaller() *Function {
 
 func (f *Frame) BindAll(f2 *Frame) *Frame {
 	for f2 != nil {
-		if f2.caller != nil {
-			f = f.Call(f2.caller)
-		} else {
+		if f2.binds() {
 			f = f.Bind(f2.symbol, f2.value)
+		} else if f2.caller != nil {
+			f = f.Call(f2.caller)
 		}
 		f2 = f2.next
 	}
@@ -69,7 +72,7 @@ func (f *Frame) String() string {
 	var sb strings.Builder
 	sb.WriteString("(")
 	for f != nil {
-		if f.caller != nil {
+		if !f.binds() {
 			f = f.next
 			continue
 		}
@@ -87,3 +90,25 @@ func (f *Frame) String() string {
 	sb.WriteString(")")
 	return sb.String()
 }
+
+// binds is false for frames which only record a caller or a context.
+func (f *Frame) binds() bool {
+	return f.caller == nil && f.value != nil
+}
+
+// Within returns the frame f evaluating as part of the evaluation of
+// caller, so that it writes to the caller's trace and is cancelled with it.
+// Closures evaluate their bodies within their callers.
+func (f *Frame) Within(caller *Frame) *Frame {
+	if f.context() == caller.context() {
+		return f
+	}
+	return &Frame{next: f, ctx: caller.context()}
+}
+
+func (f *Frame) context() *context {
+	if f == nil {
+		return nil
+	}
+	return f.ctx
+}
@@ -8,6 +8,14 @@ import (
 type Function struct {
 	Name string
 	Fn   func(env *Frame, args ...object.Value) object.Value
+
+	// WithRecur returns a copy of the function in which recur calls the
+	// given function instead. It is nil for functions which don't recur.
+	WithRecur func(recur *Function) *Function
+
+	// State holds any state a builtin keeps with the function it returns,
+	// such as the cache of a memoized function.
+	State interface{}
 }
 
 func (f *Function) First() object.Value {
@@ -25,3 +33,15 @@ func (f *Function) Type() object.Type {
 func (f *Function) String() string {
 	return fmt.Sprintf("<function %q>", f.Name)
 }
+
+// Equal reports whether v is the same function.
+func (f *Function) Equal(v object.Value) bool {
+	o, ok := v.(*Function)
+	return ok && o == f
+}
+
+// Hash returns false. Functions are compared by identity and cannot be
+// hashed.
+func (f *Function) Hash() (uint64, bool) {
+	return 0, false
+}
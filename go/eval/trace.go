@@ -3,33 +3,85 @@ package eval
 import (
 	"fmt"
 	"strings"
+	"sync"
+	"sync/atomic"
 )
 
-var t *trace
+// context is the state of one evaluation which travels with its frames:
+// its trace and whether it has been cancelled. Goroutines started by an
+// evaluation get a context of their own so their lines don't interleave
+// with the trace of the evaluation which started them. A context is
+// cancelled when any of its parents is.
+type context struct {
+	trace     *trace
+	cancelled int32
+	parent    *context
+}
 
 type trace struct {
+	mu     sync.Mutex
 	lines  []string
 	indent int
 }
 
-func BeginTrace() {
-	t = &trace{}
+// Traced returns the frame f with a new, empty trace.
+func (f *Frame) Traced() *Frame {
+	return &Frame{next: f, ctx: &context{trace: &trace{}, parent: f.context()}}
 }
 
-func EndTrace() string {
-	out := t.String()
-	t = nil
-	return out
+// Detached returns the frame f without a trace, for evaluation on another
+// goroutine.
+func (f *Frame) Detached() *Frame {
+	if f.trace() == nil {
+		return f
+	}
+	return &Frame{next: f, ctx: &context{parent: f.context()}}
+}
+
+// Cancellable returns the frame f and a function which cancels evaluation
+// within it. Evaluation checks for cancellation before each form and
+// returns an error once cancelled. Builtins blocked outside of evaluation,
+// receiving from a channel say, aren't interrupted.
+func (f *Frame) Cancellable() (*Frame, func()) {
+	c := &context{trace: f.trace(), parent: f.context()}
+	return &Frame{next: f, ctx: c}, func() {
+		atomic.StoreInt32(&c.cancelled, 1)
+	}
+}
+
+// Cancelled reports whether evaluation within f has been cancelled.
+func (f *Frame) Cancelled() bool {
+	for c := f.context(); c != nil; c = c.parent {
+		if atomic.LoadInt32(&c.cancelled) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Trace returns the lines traced by evaluation within f.
+func (f *Frame) Trace() string {
+	return f.trace().String()
 }
 
-func T(msg string, args ...interface{}) string {
-	return t.T(msg, args...)
+// T adds a line to the trace of f and returns it.
+func (f *Frame) T(msg string, args ...interface{}) string {
+	return f.trace().T(msg, args...)
+}
+
+func (f *Frame) trace() *trace {
+	if c := f.context(); c != nil {
+		return c.trace
+	}
+	return nil
 }
 
 func (t *trace) T(msg string, args ...interface{}) string {
 	if t == nil {
 		return msg
 	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
 	indent := strings.Repeat("| ", t.indent)
 	out := fmt.Sprintf(indent+msg, args...)
 	t.lines = append(t.lines, out)
@@ -40,17 +92,23 @@ func (t *trace) String() string {
 	if t == nil {
 		return "<nil>"
 	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
 	return strings.Join(t.lines, "\n")
 }
 
 func (t *trace) In() {
 	if t != nil {
+		t.mu.Lock()
 		t.indent += 1
+		t.mu.Unlock()
 	}
 }
 
 func (t *trace) Out() {
 	if t != nil {
+		t.mu.Lock()
 		t.indent -= 1
+		t.mu.Unlock()
 	}
 }
@@ -0,0 +1,61 @@
+package eval
+
+import (
+	"dabble/object"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestTracePerFrame(t *testing.T) {
+	var env *Frame
+	outer := env.Traced()
+	outer.T("outer")
+
+	var wg sync.WaitGroup
+	inner := make([]string, 4)
+	for i := range inner {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			env := outer.Detached()
+			env.T("untraced")
+			env = env.Traced()
+			env.Bind("x", object.Number(i)).T("inner %v", i)
+			inner[i] = env.Trace()
+		}(i)
+	}
+	wg.Wait()
+
+	if got := outer.Trace(); got != "outer" {
+		t.Errorf("want outer trace %q. got %q", "outer", got)
+	}
+	for i, got := range inner {
+		if want := "inner " + string(rune('0'+i)); got != want {
+			t.Errorf("want inner trace %q. got %q", want, got)
+		}
+		if strings.Contains(got, "untraced") {
+			t.Errorf("want untraced lines dropped. got %q", got)
+		}
+	}
+	if got := env.Trace(); got != "<nil>" {
+		t.Errorf("want no trace without Traced. got %q", got)
+	}
+}
+
+func TestTraceWithin(t *testing.T) {
+	var env *Frame
+	definer := env.Bind("x", object.Number(1))
+	caller := env.Traced()
+	body := definer.Within(caller)
+	body.T("in body")
+	if got := caller.Trace(); got != "in body" {
+		t.Errorf("want body traced to caller. got %q", got)
+	}
+	if got := body.Resolve("x"); got != object.Number(1) {
+		t.Errorf("want x bound to 1. got %v", got)
+	}
+	if got := body.String(); got != "((x 1))" {
+		t.Errorf("want ((x 1)). got %v", got)
+	}
+}
@@ -2,17 +2,24 @@ package lexer
 
 import (
 	"dabble/token"
+	"strconv"
+	"strings"
 	"unicode"
 	"unicode/utf8"
 )
 
 // Based on Monkey lexer.go.
 
+// Lexer reads UTF-8 input one rune at a time. Positions are byte offsets
+// into the input.
 type Lexer struct {
 	input        string
 	position     int
 	readPosition int
-	ch           byte
+	ch           rune
+
+	// start is the position of the last token read.
+	start int
 }
 
 func New(input string) *Lexer {
@@ -24,13 +31,26 @@ func New(input string) *Lexer {
 func (l *Lexer) NextToken() token.Token {
 	var tok token.Token
 
-	l.skipWhitespace()
+	if !l.skipWhitespace() {
+		tok.Type = token.ILLEGAL
+		tok.Literal = "#|"
+		return tok
+	}
+	l.start = l.position
 
 	switch l.ch {
 	case '(':
 		tok = newToken(token.LPAREN, l.ch)
 	case ')':
 		tok = newToken(token.RPAREN, l.ch)
+	case '[':
+		tok = newToken(token.LBRACKET, l.ch)
+	case ']':
+		tok = newToken(token.RBRACKET, l.ch)
+	case '{':
+		tok = newToken(token.LBRACE, l.ch)
+	case '}':
+		tok = newToken(token.RBRACE, l.ch)
 	case '.':
 		tok = newToken(token.DOT, l.ch)
 	case '\'':
@@ -38,13 +58,45 @@ func (l *Lexer) NextToken() token.Token {
 	case '`':
 		tok = newToken(token.UNQUOTE, l.ch)
 	case '"':
-		tok.Type = token.SYMBOL
-		l.readChar()
-		tok.Literal = l.readQuotedSymbol()
+		if str, ok := l.readString(); ok {
+			tok.Type = token.STRING
+			tok.Literal = str
+		} else {
+			tok.Type = token.ILLEGAL
+			tok.Literal = str
+		}
+	case '#':
+		if l.peekChar() == '{' {
+			l.readChar()
+			tok.Type = token.HASHBRACE
+			tok.Literal = "#{"
+		} else if l.peekChar() == ';' {
+			l.readChar()
+			tok.Type = token.DATUM
+			tok.Literal = "#;"
+		} else if l.peekChar() == 'u' && l.peekCharAt(1) == '8' && l.peekCharAt(2) == '(' {
+			l.readChar()
+			l.readChar()
+			l.readChar()
+			tok.Type = token.BYTES
+			tok.Literal = "#u8("
+		} else if l.peekChar() == '\\' {
+			l.readChar()
+			if char, ok := l.readCharLiteral(); ok {
+				tok.Type = token.CHAR
+				tok.Literal = char
+			} else {
+				tok.Type = token.ILLEGAL
+				tok.Literal = `#\`
+			}
+		} else {
+			tok.Type = token.SYMBOL
+			tok.Literal = l.readSymbol()
+		}
 	case 0:
 		tok.Type = token.EOF
 	default:
-		if isDigit(l.ch) {
+		if isDigit(l.ch) || isSign(l.ch) && isDigit(l.peekChar()) {
 			tok.Type = token.NUMBER
 			tok.Literal = l.readNumber()
 		} else if isSymbolChar(l.ch) {
@@ -59,8 +111,58 @@ func (l *Lexer) NextToken() token.Token {
 	return tok
 }
 
-func (l *Lexer) skipWhitespace() {
-	for isSpace(l.ch) {
+// Start returns the byte offset of the last token read.
+func (l *Lexer) Start() int {
+	return l.start
+}
+
+// Offset returns the byte offset following the last token read. It is at
+// least the length of the input once the input is exhausted.
+func (l *Lexer) Offset() int {
+	return l.position
+}
+
+// skipWhitespace skips white space, ; line comments and #| |# block
+// comments, which may be nested. It returns false if a block comment is
+// unterminated.
+func (l *Lexer) skipWhitespace() bool {
+	for {
+		switch {
+		case isSpace(l.ch):
+			l.readChar()
+		case l.ch == ';':
+			for l.ch != '\n' && l.ch != 0 {
+				l.readChar()
+			}
+		case l.ch == '#' && l.peekChar() == '|':
+			if !l.skipBlockComment() {
+				return false
+			}
+		default:
+			return true
+		}
+	}
+}
+
+// skipBlockComment skips a block comment starting at #| and any block
+// comments nested in it.
+func (l *Lexer) skipBlockComment() bool {
+	depth := 0
+	for {
+		switch {
+		case l.ch == 0:
+			return false
+		case l.ch == '#' && l.peekChar() == '|':
+			l.readChar()
+			depth++
+		case l.ch == '|' && l.peekChar() == '#':
+			l.readChar()
+			depth--
+			if depth == 0 {
+				l.readChar()
+				return true
+			}
+		}
 		l.readChar()
 	}
 }
@@ -68,27 +170,78 @@ func (l *Lexer) skipWhitespace() {
 func (l *Lexer) readChar() {
 	if l.readPosition >= len(l.input) {
 		l.ch = 0
-	} else {
-		l.ch = l.input[l.readPosition]
+		l.position = l.readPosition
+		l.readPosition += 1
+		return
 	}
+	r, size := utf8.DecodeRuneInString(l.input[l.readPosition:])
+	l.ch = r
 	l.position = l.readPosition
-	l.readPosition += 1
+	l.readPosition += size
 }
 
-func (l *Lexer) peekChar() byte {
-	if l.readPosition >= len(l.input) {
-		return 0
-	} else {
-		return l.input[l.readPosition]
+func (l *Lexer) peekChar() rune {
+	return l.peekCharAt(0)
+}
+
+// peekCharAt returns the rune offset runes after the next one.
+func (l *Lexer) peekCharAt(offset int) rune {
+	position := l.readPosition
+	for {
+		if position >= len(l.input) {
+			return 0
+		}
+		r, size := utf8.DecodeRuneInString(l.input[position:])
+		if offset == 0 {
+			return r
+		}
+		position += size
+		offset--
 	}
 }
 
-func (l *Lexer) readQuotedSymbol() string {
+// readString reads a double quoted string with Go escapes and returns its
+// contents. Line breaks may appear unescaped. If the string is unterminated,
+// has a bad escape or is not valid UTF-8 the raw text is returned instead.
+func (l *Lexer) readString() (string, bool) {
 	position := l.position
-	for isQuotedSymbolChar(l.ch) {
+	l.readChar()
+	for l.ch != '"' {
+		if l.ch == 0 {
+			return l.input[position:l.position], false
+		}
+		if l.ch == '\\' {
+			l.readChar()
+		}
 		l.readChar()
 	}
-	return l.input[position:l.position]
+	raw := l.input[position:l.readPosition]
+	if !utf8.ValidString(raw) {
+		return raw, false
+	}
+	raw = strings.NewReplacer("\n", `\n`, "\r", `\r`).Replace(raw)
+	str, err := strconv.Unquote(raw)
+	if err != nil {
+		return raw, false
+	}
+	return str, true
+}
+
+// readCharLiteral reads the text of a character literal following #\. It
+// is one character of any kind, followed by any symbol characters so that
+// names such as space can be read.
+func (l *Lexer) readCharLiteral() (string, bool) {
+	if l.peekChar() == 0 {
+		return "", false
+	}
+	l.readChar()
+	position := l.position
+	if isSymbolChar(l.ch) {
+		for isSymbolChar(l.peekChar()) {
+			l.readChar()
+		}
+	}
+	return l.input[position:l.readPosition], true
 }
 
 func (l *Lexer) readSymbol() string {
@@ -99,42 +252,66 @@ func (l *Lexer) readSymbol() string {
 	return l.input[position:l.readPosition]
 }
 
+// readNumber reads an integer, a ratio such as 1/2 or a decimal such as
+// 1.5 or 15e-1, each with an optional sign.
 func (l *Lexer) readNumber() string {
 	position := l.position
-	for isDigit(l.peekChar()) {
+	l.readDigits()
+	switch {
+	case l.peekChar() == '/' && isDigit(l.peekCharAt(1)):
 		l.readChar()
+		l.readDigits()
+	default:
+		if l.peekChar() == '.' && isDigit(l.peekCharAt(1)) {
+			l.readChar()
+			l.readDigits()
+		}
+		if l.peekChar() == 'e' || l.peekChar() == 'E' {
+			if isDigit(l.peekCharAt(1)) {
+				l.readChar()
+				l.readDigits()
+			} else if isSign(l.peekCharAt(1)) && isDigit(l.peekCharAt(2)) {
+				l.readChar()
+				l.readChar()
+				l.readDigits()
+			}
+		}
 	}
 	return l.input[position:l.readPosition]
 }
 
-func isSymbolChar(ch byte) bool {
-	if isParenChar(ch) || isSpace(ch) {
-		return false
+// readDigits advances to the last of a run of digits.
+func (l *Lexer) readDigits() {
+	for isDigit(l.peekChar()) {
+		l.readChar()
 	}
-	r, _ := utf8.DecodeRune([]byte{ch})
-	return unicode.IsPrint(r)
 }
 
-func isParenChar(ch byte) bool {
-	return ch == '(' || ch == ')'
-}
-
-func isQuotedSymbolChar(ch byte) bool {
-	if ch == '"' {
+// isSymbolChar reports whether ch may appear in a symbol. A ; starts a
+// comment. Invalid UTF-8 decodes to utf8.RuneError and is rejected.
+func isSymbolChar(ch rune) bool {
+	if isParenChar(ch) || isSpace(ch) || ch == ';' || ch == utf8.RuneError {
 		return false
 	}
-	r, _ := utf8.DecodeRune([]byte{ch})
-	return unicode.IsPrint(r)
+	return unicode.IsPrint(ch)
+}
+
+func isParenChar(ch rune) bool {
+	return ch == '(' || ch == ')' || ch == '[' || ch == ']' || ch == '{' || ch == '}'
 }
 
-func isDigit(ch byte) bool {
+func isDigit(ch rune) bool {
 	return '0' <= ch && ch <= '9'
 }
 
-func isSpace(ch byte) bool {
+func isSign(ch rune) bool {
+	return ch == '-' || ch == '+'
+}
+
+func isSpace(ch rune) bool {
 	return ch == ' ' || ch == '\t' || ch == '\n' || ch == '\r'
 }
 
-func newToken(tokenType token.TokenType, ch byte) token.Token {
+func newToken(tokenType token.TokenType, ch rune) token.Token {
 	return token.Token{Type: tokenType, Literal: string(ch)}
 }
@@ -25,7 +25,18 @@ foo
 " "
 (let (a 1) (+ 1 a))
 ""
-`
+"say \"hi\"\n\t\\"
+"héllo
+world"
+-12 +3 - -a a-1
+1.5 -1/2 1e10 +2.5E-3 6e (1 . 2)
+(λ (é) "日本語") café→1 ¿
+#\a #\space (#\() #\λ) # #foo
+[1 [a]] a[b]
+{a 1} a{b}
+#{a} #{}
+#u8(1 255) #u8
+"unterminated`
 
 	tests := []struct {
 		expectedType    token.TokenType
@@ -42,15 +53,15 @@ foo
 		{token.SYMBOL, "foo"},
 		{token.SYMBOL, "bar"},
 		{token.RPAREN, ")"},
-		{token.SYMBOL, "foo"},
-		{token.SYMBOL, "foo bar"},
+		{token.STRING, "foo"},
+		{token.STRING, "foo bar"},
 		{token.LPAREN, "("},
-		{token.SYMBOL, "foo bar"},
-		{token.SYMBOL, "baz bam"},
+		{token.STRING, "foo bar"},
+		{token.STRING, "baz bam"},
 		{token.RPAREN, ")"},
 		{token.LPAREN, "("},
-		{token.SYMBOL, "foo"},
-		{token.SYMBOL, "bar"},
+		{token.STRING, "foo"},
+		{token.STRING, "bar"},
 		{token.RPAREN, ")"},
 		{token.LPAREN, "("},
 		{token.LPAREN, "("},
@@ -61,9 +72,9 @@ foo
 		{token.LPAREN, "("},
 		{token.RPAREN, ")"},
 		{token.RPAREN, ")"},
-		{token.SYMBOL, "+"},
-		{token.SYMBOL, "("},
-		{token.SYMBOL, " "},
+		{token.STRING, "+"},
+		{token.STRING, "("},
+		{token.STRING, " "},
 		{token.LPAREN, "("},
 		{token.SYMBOL, "let"},
 		{token.LPAREN, "("},
@@ -76,7 +87,72 @@ foo
 		{token.SYMBOL, "a"},
 		{token.RPAREN, ")"},
 		{token.RPAREN, ")"},
-		{token.SYMBOL, ""},
+		{token.STRING, ""},
+		{token.STRING, "say \"hi\"\n\t\\"},
+		{token.STRING, "héllo\nworld"},
+		{token.NUMBER, "-12"},
+		{token.NUMBER, "+3"},
+		{token.SYMBOL, "-"},
+		{token.SYMBOL, "-a"},
+		{token.SYMBOL, "a-1"},
+		{token.NUMBER, "1.5"},
+		{token.NUMBER, "-1/2"},
+		{token.NUMBER, "1e10"},
+		{token.NUMBER, "+2.5E-3"},
+		{token.NUMBER, "6"},
+		{token.SYMBOL, "e"},
+		{token.LPAREN, "("},
+		{token.NUMBER, "1"},
+		{token.DOT, "."},
+		{token.NUMBER, "2"},
+		{token.RPAREN, ")"},
+		{token.LPAREN, "("},
+		{token.SYMBOL, "λ"},
+		{token.LPAREN, "("},
+		{token.SYMBOL, "é"},
+		{token.RPAREN, ")"},
+		{token.STRING, "日本語"},
+		{token.RPAREN, ")"},
+		{token.SYMBOL, "café→1"},
+		{token.SYMBOL, "¿"},
+		{token.CHAR, "a"},
+		{token.CHAR, "space"},
+		{token.LPAREN, "("},
+		{token.CHAR, "("},
+		{token.RPAREN, ")"},
+		{token.CHAR, "λ"},
+		{token.RPAREN, ")"},
+		{token.SYMBOL, "#"},
+		{token.SYMBOL, "#foo"},
+		{token.LBRACKET, "["},
+		{token.NUMBER, "1"},
+		{token.LBRACKET, "["},
+		{token.SYMBOL, "a"},
+		{token.RBRACKET, "]"},
+		{token.RBRACKET, "]"},
+		{token.SYMBOL, "a"},
+		{token.LBRACKET, "["},
+		{token.SYMBOL, "b"},
+		{token.RBRACKET, "]"},
+		{token.LBRACE, "{"},
+		{token.SYMBOL, "a"},
+		{token.NUMBER, "1"},
+		{token.RBRACE, "}"},
+		{token.SYMBOL, "a"},
+		{token.LBRACE, "{"},
+		{token.SYMBOL, "b"},
+		{token.RBRACE, "}"},
+		{token.HASHBRACE, "#{"},
+		{token.SYMBOL, "a"},
+		{token.RBRACE, "}"},
+		{token.HASHBRACE, "#{"},
+		{token.RBRACE, "}"},
+		{token.BYTES, "#u8("},
+		{token.NUMBER, "1"},
+		{token.NUMBER, "255"},
+		{token.RPAREN, ")"},
+		{token.SYMBOL, "#u8"},
+		{token.ILLEGAL, "\"unterminated"},
 		{token.EOF, ""},
 	}
 
@@ -96,3 +172,48 @@ foo
 		}
 	}
 }
+
+func TestNextTokenInvalidUTF8(t *testing.T) {
+	l := New("é \xff\u00a0 \"\xe2\x82\"")
+	want := []token.Token{
+		{Type: token.SYMBOL, Literal: "é"},
+		{Type: token.ILLEGAL, Literal: "\ufffd"},
+		{Type: token.ILLEGAL, Literal: "\u00a0"},
+		{Type: token.ILLEGAL, Literal: "\"\xe2\x82\""},
+		{Type: token.EOF, Literal: ""},
+	}
+	for i, w := range want {
+		tok := l.NextToken()
+		if tok != w {
+			t.Fatalf("tokens[%d] - expected=%q, got=%q", i, w, tok)
+		}
+	}
+}
+
+func TestNextTokenComments(t *testing.T) {
+	l := New(`; a line comment
+a;b another (
+#| block
+   #| nested |# c |#d
+#;(e f) #\; "; not a comment"
+#| unterminated #| |#`)
+	want := []token.Token{
+		{Type: token.SYMBOL, Literal: "a"},
+		{Type: token.SYMBOL, Literal: "d"},
+		{Type: token.DATUM, Literal: "#;"},
+		{Type: token.LPAREN, Literal: "("},
+		{Type: token.SYMBOL, Literal: "e"},
+		{Type: token.SYMBOL, Literal: "f"},
+		{Type: token.RPAREN, Literal: ")"},
+		{Type: token.CHAR, Literal: ";"},
+		{Type: token.STRING, Literal: "; not a comment"},
+		{Type: token.ILLEGAL, Literal: "#|"},
+		{Type: token.EOF, Literal: ""},
+	}
+	for i, w := range want {
+		tok := l.NextToken()
+		if tok != w {
+			t.Fatalf("tokens[%d] - expected=%q, got=%q", i, w, tok)
+		}
+	}
+}
@@ -0,0 +1,183 @@
+package object
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Actor is a process with a mailbox. Messages are kept in the order they
+// were sent and may be received out of order by selecting on them. When an
+// actor finishes with an error every linked actor is sent
+// (exit <actor> <message>).
+type Actor struct {
+	id int64
+
+	mu      sync.Mutex
+	mailbox []mail
+	sent    int64
+	arrived chan struct{}
+	links   []*Actor
+
+	done  chan struct{}
+	value Value
+}
+
+var actors int64
+
+// mail is a message in a mailbox, numbered in the order it was sent.
+type mail struct {
+	n       int64
+	message Value
+}
+
+// NewActor returns an actor with an empty mailbox. It is not running until
+// Start is called, but can already send and receive messages, so a Go
+// caller can act as an actor itself.
+func NewActor() *Actor {
+	return &Actor{
+		id:      atomic.AddInt64(&actors, 1),
+		arrived: make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+}
+
+// Start runs fn on a new goroutine as the body of the actor.
+func (a *Actor) Start(fn func() Value) {
+	go func() {
+		value := Protect(fn)
+		a.mu.Lock()
+		a.value = value
+		links := a.links
+		close(a.done)
+		a.mu.Unlock()
+		if value.Type() == ERROR {
+			for _, l := range links {
+				l.Send(a.exit())
+			}
+		}
+	}()
+}
+
+// Send puts a message in the mailbox.
+func (a *Actor) Send(message Value) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.sent++
+	a.mailbox = append(a.mailbox, mail{a.sent, message})
+	close(a.arrived)
+	a.arrived = make(chan struct{})
+}
+
+// Receive removes and returns the oldest message for which match returns
+// true, waiting for one to arrive if needed. A negative timeout waits
+// forever. It returns false if the timeout passes first. Match is called
+// without the mailbox locked, so it may send to the actor.
+func (a *Actor) Receive(match func(Value) bool, timeout time.Duration) (Value, bool) {
+	var expired <-chan time.Time
+	if timeout >= 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		expired = timer.C
+	}
+	for {
+		a.mu.Lock()
+		mailbox := append([]mail(nil), a.mailbox...)
+		arrived := a.arrived
+		a.mu.Unlock()
+		if m, ok, taken := a.take(mailbox, match); ok {
+			return m, true
+		} else if taken {
+			// Another receiver took the matching message first.
+			continue
+		}
+		select {
+		case <-arrived:
+		case <-expired:
+			return nil, false
+		}
+	}
+}
+
+// take finds the oldest message in a copy of the mailbox for which match
+// returns true and removes it from the mailbox. It returns taken if the
+// message had already been removed.
+func (a *Actor) take(mailbox []mail, match func(Value) bool) (m Value, ok, taken bool) {
+	for _, c := range mailbox {
+		if !match(c.message) {
+			continue
+		}
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		for i, d := range a.mailbox {
+			if d.n == c.n {
+				a.mailbox = append(a.mailbox[:i:i], a.mailbox[i+1:]...)
+				return c.message, true, false
+			}
+		}
+		return nil, false, true
+	}
+	return nil, false, false
+}
+
+// Link makes a and b notify each other when they finish with an error. If
+// either has already failed the other is notified straight away.
+func (a *Actor) Link(b *Actor) {
+	a.link(b)
+	b.link(a)
+}
+
+func (a *Actor) link(b *Actor) {
+	a.mu.Lock()
+	select {
+	case <-a.done:
+		failed := a.value.Type() == ERROR
+		a.mu.Unlock()
+		if failed {
+			b.Send(a.exit())
+		}
+	default:
+		a.links = append(a.links, b)
+		a.mu.Unlock()
+	}
+}
+
+func (a *Actor) exit() Value {
+	return Cell(Symbol("exit"), Cell(a, Cell(Symbol(a.value.(Error)), Nil)))
+}
+
+// Await blocks until the actor has finished and returns its result.
+func (a *Actor) Await() Value {
+	<-a.done
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.value
+}
+
+func (a *Actor) First() Value {
+	return Nil
+}
+
+func (a *Actor) Rest() Value {
+	return Nil
+}
+
+func (a *Actor) Type() Type {
+	return ACTOR
+}
+
+func (a *Actor) String() string {
+	return fmt.Sprintf("<actor %v>", a.id)
+}
+
+// Equal reports whether v is the same actor.
+func (a *Actor) Equal(v Value) bool {
+	o, ok := v.(*Actor)
+	return ok && o == a
+}
+
+// Hash returns false. Actors are compared by identity and cannot be hashed.
+func (a *Actor) Hash() (uint64, bool) {
+	return 0, false
+}
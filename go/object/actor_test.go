@@ -0,0 +1,86 @@
+package object
+
+import (
+	"testing"
+)
+
+func TestActorReceive(t *testing.T) {
+	a := NewActor()
+	a.Send(Number(1))
+	a.Send(Symbol("b"))
+	a.Send(Number(3))
+
+	isSymbol := func(v Value) bool { return v.Type() == SYMBOL }
+	anything := func(Value) bool { return true }
+
+	if m, ok := a.Receive(isSymbol, -1); !ok || m != Symbol("b") {
+		t.Errorf("want b. got %v %v", m, ok)
+	}
+	if m, ok := a.Receive(isSymbol, 0); ok {
+		t.Errorf("want timeout. got %v", m)
+	}
+	if m, ok := a.Receive(anything, 0); !ok || m != Number(1) {
+		t.Errorf("want 1. got %v %v", m, ok)
+	}
+	go a.Send(Symbol("late"))
+	if m, ok := a.Receive(isSymbol, -1); !ok || m != Symbol("late") {
+		t.Errorf("want late. got %v %v", m, ok)
+	}
+	if m, ok := a.Receive(anything, 0); !ok || m != Number(3) {
+		t.Errorf("want 3. got %v %v", m, ok)
+	}
+}
+
+func TestActorReceiveSendsToSelf(t *testing.T) {
+	a := NewActor()
+	a.Send(Number(1))
+	sent := false
+	m, ok := a.Receive(func(v Value) bool {
+		if !sent {
+			sent = true
+			a.Send(Number(2))
+		}
+		return v == Number(2)
+	}, -1)
+	if !ok || m != Number(2) {
+		t.Errorf("want 2. got %v %v", m, ok)
+	}
+	if m, ok := a.Receive(func(Value) bool { return true }, 0); !ok || m != Number(1) {
+		t.Errorf("want 1 left. got %v %v", m, ok)
+	}
+}
+
+func TestActorLink(t *testing.T) {
+	isExit := func(v Value) bool { return v.Type() == CELL && v.First() == Symbol("exit") }
+
+	watcher := NewActor()
+	failing := NewActor()
+	watcher.Link(failing)
+	failing.Start(func() Value { return Error("boom") })
+	m, ok := watcher.Receive(isExit, -1)
+	if !ok {
+		t.Fatalf("want exit message")
+	}
+	if got, want := m.String(), "(exit "+failing.String()+" boom)"; got != want {
+		t.Errorf("want %v. got %v", want, got)
+	}
+
+	// Linking to an actor which already failed notifies straight away.
+	late := NewActor()
+	late.Link(failing)
+	if _, ok := late.Receive(isExit, 0); !ok {
+		t.Errorf("want exit message for late link")
+	}
+
+	// Actors which finish without error don't notify.
+	fine := NewActor()
+	fine.Start(func() Value { return Number(1) })
+	other := NewActor()
+	other.Link(fine)
+	if got := fine.Await(); got != Number(1) {
+		t.Errorf("want 1. got %v", got)
+	}
+	if m, ok := other.Receive(isExit, 0); ok {
+		t.Errorf("want no exit message. got %v", m)
+	}
+}
@@ -0,0 +1,249 @@
+package object
+
+import (
+	"math/big"
+)
+
+// Numbers form a tower: integers (Number and BigInt), then Ratio, then
+// Float. Arithmetic on mixed arguments is carried out at the higher of the
+// two levels. Exact results are always normalized, so a Ratio with a
+// denominator of 1 becomes an integer and a BigInt which fits becomes a
+// Number.
+
+const (
+	integerLevel = iota
+	ratioLevel
+	floatLevel
+)
+
+// IsNumber reports whether v is any kind of number.
+func IsNumber(v Value) bool {
+	switch v.Type() {
+	case NUMBER, BIGINT, RATIO, FLOAT:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsInteger reports whether v is an integer, whether a Number or a BigInt.
+func IsInteger(v Value) bool {
+	switch v.Type() {
+	case NUMBER, BIGINT:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsExact reports whether v is a number other than a Float.
+func IsExact(v Value) bool {
+	return IsNumber(v) && v.Type() != FLOAT
+}
+
+// Add returns a + b. The arguments must be numbers. Integer sums which
+// overflow a Number are promoted to a BigInt.
+func Add(a, b Value) Value {
+	switch level(a, b) {
+	case floatLevel:
+		return toFloat(a) + toFloat(b)
+	case ratioLevel:
+		return Rational(new(big.Rat).Add(toRat(a), toRat(b)))
+	}
+	if n, m, ok := numbers(a, b); ok {
+		if sum, ok := n.Add(m); ok {
+			return sum
+		}
+	}
+	return Integer(new(big.Int).Add(bigInt(a), bigInt(b)))
+}
+
+// Sub returns a - b. The arguments must be numbers.
+func Sub(a, b Value) Value {
+	switch level(a, b) {
+	case floatLevel:
+		return toFloat(a) - toFloat(b)
+	case ratioLevel:
+		return Rational(new(big.Rat).Sub(toRat(a), toRat(b)))
+	}
+	if n, m, ok := numbers(a, b); ok {
+		if diff, ok := n.Sub(m); ok {
+			return diff
+		}
+	}
+	return Integer(new(big.Int).Sub(bigInt(a), bigInt(b)))
+}
+
+// Mul returns a * b. The arguments must be numbers.
+func Mul(a, b Value) Value {
+	switch level(a, b) {
+	case floatLevel:
+		return toFloat(a) * toFloat(b)
+	case ratioLevel:
+		return Rational(new(big.Rat).Mul(toRat(a), toRat(b)))
+	}
+	if n, m, ok := numbers(a, b); ok {
+		if product, ok := n.Mul(m); ok {
+			return product
+		}
+	}
+	return Integer(new(big.Int).Mul(bigInt(a), bigInt(b)))
+}
+
+// Quo returns a / b, and false if b is zero. The arguments must be numbers.
+// The quotient of exact numbers is exact.
+func Quo(a, b Value) (Value, bool) {
+	if Sign(b) == 0 {
+		return nil, false
+	}
+	if level(a, b) == floatLevel {
+		return toFloat(a) / toFloat(b), true
+	}
+	return Rational(new(big.Rat).Quo(toRat(a), toRat(b))), true
+}
+
+// Quotient returns a / b truncated towards zero, and false if b is zero.
+// The arguments must be integers.
+func Quotient(a, b Value) (Value, bool) {
+	if Sign(b) == 0 {
+		return nil, false
+	}
+	if n, m, ok := numbers(a, b); ok {
+		if quo, ok := n.Quo(m); ok {
+			return quo, true
+		}
+	}
+	return Integer(new(big.Int).Quo(bigInt(a), bigInt(b))), true
+}
+
+// Mod returns a modulo b, which has the sign of b, and false if b is zero.
+// The arguments must be integers.
+func Mod(a, b Value) (Value, bool) {
+	if Sign(b) == 0 {
+		return nil, false
+	}
+	if n, m, ok := numbers(a, b); ok {
+		return n.Mod(m)
+	}
+	y := bigInt(b)
+	mod := new(big.Int).Rem(bigInt(a), y)
+	if mod.Sign() != 0 && mod.Sign() != y.Sign() {
+		mod.Add(mod, y)
+	}
+	return Integer(mod), true
+}
+
+// Cmp compares two numbers, returning -1, 0 or 1. Floats are compared
+// with exact numbers exactly, without rounding the exact number. NaN is
+// unordered and compares as 0.
+func Cmp(a, b Value) int {
+	switch level(a, b) {
+	case floatLevel:
+		x, xok := a.(Float)
+		y, yok := b.(Float)
+		switch {
+		case xok && yok:
+			return cmpFloat(x, y)
+		case xok && !x.IsFinite():
+			return cmpFloat(x, 0)
+		case yok && !y.IsFinite():
+			return cmpFloat(0, y)
+		}
+		// A finite float converts to a Rat without loss.
+		return toRat(a).Cmp(toRat(b))
+	case ratioLevel:
+		return toRat(a).Cmp(toRat(b))
+	}
+	if n, m, ok := numbers(a, b); ok {
+		switch {
+		case n < m:
+			return -1
+		case n > m:
+			return 1
+		default:
+			return 0
+		}
+	}
+	return bigInt(a).Cmp(bigInt(b))
+}
+
+// Sign returns -1, 0 or 1 for a negative, zero or positive number.
+func Sign(a Value) int {
+	return Cmp(a, Number(0))
+}
+
+func level(a, b Value) int {
+	l, m := levelOf(a), levelOf(b)
+	if m > l {
+		return m
+	}
+	return l
+}
+
+func levelOf(v Value) int {
+	switch v.Type() {
+	case FLOAT:
+		return floatLevel
+	case RATIO:
+		return ratioLevel
+	default:
+		return integerLevel
+	}
+}
+
+func numbers(a, b Value) (Number, Number, bool) {
+	n, ok := a.(Number)
+	if !ok {
+		return 0, 0, false
+	}
+	m, ok := b.(Number)
+	return n, m, ok
+}
+
+func bigInt(v Value) *big.Int {
+	switch v := v.(type) {
+	case Number:
+		return big.NewInt(int64(v))
+	case *BigInt:
+		return v.i
+	default:
+		panic("not an integer: " + v.String())
+	}
+}
+
+func toRat(v Value) *big.Rat {
+	switch v := v.(type) {
+	case *Ratio:
+		return v.r
+	case Float:
+		return new(big.Rat).SetFloat64(float64(v))
+	default:
+		return new(big.Rat).SetInt(bigInt(v))
+	}
+}
+
+func toFloat(v Value) Float {
+	switch v := v.(type) {
+	case Float:
+		return v
+	case Number:
+		return Float(v)
+	case *Ratio:
+		f, _ := v.r.Float64()
+		return Float(f)
+	default:
+		f, _ := new(big.Float).SetInt(bigInt(v)).Float64()
+		return Float(f)
+	}
+}
+
+func cmpFloat(x, y Float) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	default:
+		return 0
+	}
+}
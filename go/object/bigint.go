@@ -0,0 +1,50 @@
+package object
+
+import (
+	"math/big"
+)
+
+// BigInt is an integer outside the range of Number. Integers which fit in a
+// Number are always represented by one, so that each integer has a single
+// representation. Use Integer to construct them.
+type BigInt struct {
+	i *big.Int
+}
+
+// Integer returns i as a Number if it fits or a BigInt otherwise.
+func Integer(i *big.Int) Value {
+	if i.IsInt64() {
+		return Number(i.Int64())
+	}
+	return &BigInt{new(big.Int).Set(i)}
+}
+
+// Int returns a copy of the integer.
+func (b *BigInt) Int() *big.Int {
+	return new(big.Int).Set(b.i)
+}
+
+func (b *BigInt) First() Value {
+	return Number(b.i.Bit(0))
+}
+
+func (b *BigInt) Rest() Value {
+	return Integer(new(big.Int).Rsh(b.i, 1))
+}
+
+func (b *BigInt) Type() Type {
+	return BIGINT
+}
+
+func (b *BigInt) String() string {
+	return b.i.String()
+}
+
+func (b *BigInt) Equal(v Value) bool {
+	o, ok := v.(*BigInt)
+	return ok && o.i.Cmp(b.i) == 0
+}
+
+func (b *BigInt) Hash() (uint64, bool) {
+	return hashString(BIGINT, b.i.String()), true
+}
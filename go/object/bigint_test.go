@@ -0,0 +1,104 @@
+package object
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestInteger(t *testing.T) {
+	tests := []struct {
+		input  string
+		want   Type
+		first  string
+		rest   string
+		string string
+	}{{
+		input:  "9223372036854775807",
+		want:   NUMBER,
+		first:  "1",
+		rest:   "4611686018427387903",
+		string: "9223372036854775807",
+	}, {
+		input:  "9223372036854775808",
+		want:   BIGINT,
+		first:  "0",
+		rest:   "4611686018427387904",
+		string: "9223372036854775808",
+	}, {
+		input:  "18446744073709551617",
+		want:   BIGINT,
+		first:  "1",
+		rest:   "9223372036854775808",
+		string: "18446744073709551617",
+	}, {
+		input:  "-9223372036854775809",
+		want:   BIGINT,
+		first:  "1",
+		rest:   "-4611686018427387905",
+		string: "-9223372036854775809",
+	}}
+
+	for _, tt := range tests {
+		i, _ := new(big.Int).SetString(tt.input, 10)
+		v := Integer(i)
+		if v.Type() != tt.want {
+			t.Errorf("given %v. want type %v. got %v", tt.input, tt.want, v.Type())
+		}
+		if got := v.First().String(); got != tt.first {
+			t.Errorf("given %v. want first %v. got %v", tt.input, tt.first, got)
+		}
+		if got := v.Rest().String(); got != tt.rest {
+			t.Errorf("given %v. want rest %v. got %v", tt.input, tt.rest, got)
+		}
+		if got := v.String(); got != tt.string {
+			t.Errorf("given %v. want string %q. got %q", tt.input, tt.string, got)
+		}
+	}
+}
+
+func TestIntegerArith(t *testing.T) {
+	integer := func(s string) Value {
+		i, _ := new(big.Int).SetString(s, 10)
+		return Integer(i)
+	}
+	quo := func(a, b Value) Value {
+		v, _ := Quotient(a, b)
+		return v
+	}
+	mod := func(a, b Value) Value {
+		v, _ := Mod(a, b)
+		return v
+	}
+	tests := []struct {
+		name string
+		op   func(Value, Value) Value
+		a, b string
+		want string
+		typ  Type
+	}{
+		{"add promotes", Add, "9223372036854775807", "1", "9223372036854775808", BIGINT},
+		{"add demotes", Add, "9223372036854775808", "-1", "9223372036854775807", NUMBER},
+		{"sub promotes", Sub, "-9223372036854775808", "1", "-9223372036854775809", BIGINT},
+		{"sub demotes", Sub, "100000000000000000000", "100000000000000000000", "0", NUMBER},
+		{"mul promotes", Mul, "4294967296", "4294967296", "18446744073709551616", BIGINT},
+		{"quo demotes", quo, "18446744073709551616", "4294967296", "4294967296", NUMBER},
+		{"quo truncates", quo, "-100000000000000000001", "10", "-10000000000000000000", BIGINT},
+		{"mod sign of divisor", mod, "-100000000000000000000", "7", "5", NUMBER},
+		{"mod negative divisor", mod, "100000000000000000000", "-7", "-5", NUMBER},
+	}
+	for _, tt := range tests {
+		got := tt.op(integer(tt.a), integer(tt.b))
+		if got.String() != tt.want || got.Type() != tt.typ {
+			t.Errorf("%v: given %v and %v. want %v %v. got %v %v", tt.name, tt.a, tt.b, tt.typ, tt.want, got.Type(), got)
+		}
+	}
+	if _, ok := Quo(integer("100000000000000000000"), Number(0)); ok {
+		t.Errorf("want division by zero to fail")
+	}
+	if Cmp(integer("-100000000000000000000"), Number(-1)) != -1 {
+		t.Errorf("want big negative less than -1")
+	}
+	if Cmp(integer("100000000000000000000"), integer("100000000000000000000")) != 0 {
+		t.Errorf("want equal big integers to compare equal")
+	}
+}
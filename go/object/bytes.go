@@ -0,0 +1,51 @@
+package object
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Bytes is immutable binary data, the counterpart of the BYTES1-4 chains in
+// the WAT runtime. As a list its First is the first byte as a Number and
+// its Rest is the remaining bytes.
+type Bytes string
+
+func (b Bytes) First() Value {
+	if b == "" {
+		return Nil
+	}
+	return Number(b[0])
+}
+
+func (b Bytes) Rest() Value {
+	if len(b) < 2 {
+		return Nil
+	}
+	return b[1:]
+}
+
+func (b Bytes) Type() Type {
+	return BYTES
+}
+
+func (b Bytes) String() string {
+	var s strings.Builder
+	s.WriteString("#u8(")
+	for i := 0; i < len(b); i++ {
+		if i > 0 {
+			s.WriteString(" ")
+		}
+		s.WriteString(strconv.Itoa(int(b[i])))
+	}
+	s.WriteString(")")
+	return s.String()
+}
+
+func (b Bytes) Equal(v Value) bool {
+	o, ok := v.(Bytes)
+	return ok && o == b
+}
+
+func (b Bytes) Hash() (uint64, bool) {
+	return hashString(BYTES, string(b)), true
+}
@@ -0,0 +1,42 @@
+package object
+
+import "testing"
+
+func TestBytes(t *testing.T) {
+	tests := []struct {
+		bytes  Bytes
+		first  string
+		rest   string
+		string string
+	}{{
+		bytes:  "",
+		first:  "()",
+		rest:   "()",
+		string: "#u8()",
+	}, {
+		bytes:  "\x01",
+		first:  "1",
+		rest:   "()",
+		string: "#u8(1)",
+	}, {
+		bytes:  "\xffab",
+		first:  "255",
+		rest:   "#u8(97 98)",
+		string: "#u8(255 97 98)",
+	}}
+
+	for _, tt := range tests {
+		first := tt.bytes.First().String()
+		if first != tt.first {
+			t.Errorf("given %q. want first %v. got %v", tt.bytes, tt.first, first)
+		}
+		rest := tt.bytes.Rest().String()
+		if rest != tt.rest {
+			t.Errorf("given %q. want rest %v. got %v", tt.bytes, tt.rest, rest)
+		}
+		got := tt.bytes.String()
+		if got != tt.string {
+			t.Errorf("given %q. want string %q. got %q", tt.bytes, tt.string, got)
+		}
+	}
+}
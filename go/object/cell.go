@@ -2,10 +2,48 @@ package object
 
 import (
 	"fmt"
+	"math"
 	"strings"
+	"sync"
+	"sync/atomic"
 )
 
-type cell [2]Value
+type cell struct {
+	first, rest Value
+
+	// epoch is non-zero for cells interned while hash consing was on, and
+	// hash is then the hash of the cell. A canonical interned cell holds
+	// only values which are Equal exactly when they are identical, so it
+	// is the only cell of its epoch Equal to it.
+	epoch     uint64
+	hash      uint64
+	canonical bool
+}
+
+var (
+	hashConsing int32
+	internMu    sync.Mutex
+	interned    = map[uint64][]*cell{}
+	internEpoch = uint64(1)
+)
+
+// SetHashConsing turns hash consing of cells on or off. While it is on
+// Cell returns the same cell for identical elements, so cells share memory
+// and cells of values such as symbols and numbers are compared by pointer.
+// Cells with elements that can't be hashed are not interned. Interned cells
+// are kept until hash consing is turned off, and cells interned before then
+// are no longer shared with cells interned after it is turned on again.
+func SetHashConsing(on bool) {
+	internMu.Lock()
+	defer internMu.Unlock()
+	if on {
+		atomic.StoreInt32(&hashConsing, 1)
+	} else {
+		atomic.StoreInt32(&hashConsing, 0)
+		interned = map[uint64][]*cell{}
+		internEpoch++
+	}
+}
 
 func Cell(v1, v2 Value) Value {
 	if v1 == nil {
@@ -14,38 +52,113 @@ func Cell(v1, v2 Value) Value {
 	if v2 == nil {
 		v2 = Nil
 	}
-	return cell{v1, v2}
+	if atomic.LoadInt32(&hashConsing) == 1 {
+		return intern(v1, v2)
+	}
+	return &cell{first: v1, rest: v2}
+}
+
+func intern(first, rest Value) Value {
+	h, ok := hashAll(hashString(CELL, ""), first, rest)
+	if !ok {
+		return &cell{first: first, rest: rest}
+	}
+	internMu.Lock()
+	defer internMu.Unlock()
+	for _, c := range interned[h] {
+		if same(c.first, first) && same(c.rest, rest) {
+			return c
+		}
+	}
+	c := &cell{
+		first:     first,
+		rest:      rest,
+		epoch:     internEpoch,
+		hash:      h,
+		canonical: canonical(first) && canonical(rest),
+	}
+	interned[h] = append(interned[h], c)
+	return c
+}
+
+// same reports whether a and b can't be told apart, so that an interned
+// cell holding a may be returned for b. Floats are compared by their bits
+// since 0.0 and -0.0 are Equal but print differently.
+func same(a, b Value) bool {
+	if f, ok := a.(Float); ok {
+		g, ok := b.(Float)
+		return ok && math.Float64bits(float64(f)) == math.Float64bits(float64(g))
+	}
+	return Identical(a, b)
 }
 
-func (c cell) First() Value {
-	return c[0]
+// canonical reports whether v is only Equal to values identical to it.
+// Called with internMu held.
+func canonical(v Value) bool {
+	switch v.Type() {
+	case SYMBOL, STRING, CHAR, BYTES, NUMBER, BIGINT, RATIO, NIL:
+		return true
+	case CELL:
+		c := v.(*cell)
+		return c.canonical && c.epoch == internEpoch
+	default:
+		return false
+	}
+}
+
+func (c *cell) First() Value {
+	return c.first
 }
 
-func (c cell) Rest() Value {
-	return c[1]
+func (c *cell) Rest() Value {
+	return c.rest
 }
 
-func (c cell) Type() Type {
+func (c *cell) Type() Type {
 	return CELL
 }
 
-func (c cell) String() string {
-	first, rest := c[0], c[1]
-	if first == nil {
-		first = Nil
-	}
-	if rest == nil {
-		rest = Nil
-	}
+func (c *cell) String() string {
 	var b strings.Builder
-	fmt.Fprintf(&b, "(%v", first.String())
+	fmt.Fprintf(&b, "(%v", Print(c.first))
+	rest := c.rest
 	for rest.Type() == CELL {
-		fmt.Fprintf(&b, " %v", rest.First().String())
+		fmt.Fprintf(&b, " %v", Print(rest.First()))
 		rest = rest.Rest()
 	}
 	if rest.Type() != NIL {
-		fmt.Fprintf(&b, " %v", rest.String())
+		fmt.Fprintf(&b, " %v", Print(rest))
 	}
 	fmt.Fprintf(&b, ")")
 	return b.String()
 }
+
+func (c *cell) Equal(v Value) bool {
+	o, ok := v.(*cell)
+	if !ok {
+		return false
+	}
+	if c == o {
+		return true
+	}
+	if c.canonical && o.canonical && c.epoch == o.epoch {
+		return false
+	}
+	return Equal(c.first, o.first) && Equal(forced(c.rest), forced(o.rest))
+}
+
+// forced forces a promise in the rest of a cell, so that a stream equals
+// the list of its elements.
+func forced(v Value) Value {
+	if p, ok := v.(*Promise); ok {
+		return p.Force()
+	}
+	return v
+}
+
+func (c *cell) Hash() (uint64, bool) {
+	if c.epoch != 0 {
+		return c.hash, true
+	}
+	return hashAll(hashString(CELL, ""), c.first, c.rest)
+}
@@ -1,6 +1,9 @@
 package object
 
 import (
+	"fmt"
+	"math"
+	"math/big"
 	"strconv"
 	"testing"
 )
@@ -59,3 +62,97 @@ func TestCell(t *testing.T) {
 		})
 	}
 }
+
+func TestHashConsing(t *testing.T) {
+	SetHashConsing(true)
+	defer SetHashConsing(false)
+
+	a := Cell(Number(1), Cell(Symbol("a"), Nil))
+	b := Cell(Number(1), Cell(Symbol("a"), Nil))
+	if a != b {
+		t.Errorf("want the same cell for %v and %v", a, b)
+	}
+	if c := Cell(Number(1), Cell(Symbol("b"), Nil)); a == c || Equal(a, c) {
+		t.Errorf("want different cells for %v and %v", a, c)
+	}
+	ratio := Cell(Rational(big.NewRat(1, 2)), Nil)
+	if r := Cell(Rational(big.NewRat(2, 4)), Nil); ratio != r {
+		t.Errorf("want the same cell for Equal elements %v and %v", ratio, r)
+	}
+	ch := NewChannel(0)
+	if u := Cell(ch, Nil); u == Cell(ch, Nil) || !Equal(u, Cell(ch, Nil)) {
+		t.Errorf("want unhashable elements not to be interned and to compare structurally")
+	}
+	zero, negZero := Cell(Float(0), Nil), Cell(Float(math.Copysign(0, -1)), Nil)
+	if zero == negZero || negZero.String() != "(-0.0)" || !Equal(zero, negZero) {
+		t.Errorf("want (0.0) and (-0.0) to be Equal but different cells. got %v and %v", zero, negZero)
+	}
+	v1, v2 := NewVector([]Value{Number(1)}), NewVector([]Value{Number(1)})
+	c1, c2 := Cell(v1, Nil), Cell(v2, Nil)
+	if c1 == c2 || c2.First() != v2 || Identical(c1, c2) || !Equal(c1, c2) {
+		t.Errorf("want cells of different vectors to hold their own vectors")
+	}
+	if Cell(v1, Nil) != c1 {
+		t.Errorf("want the same cell for the same vector")
+	}
+	r1, r2 := &row{1, "a"}, &row{1, "a again"}
+	if l := Cell(r2, Nil); Cell(r1, Nil) == l || l.First() != r2 || !Equal(Cell(r1, Nil), l) {
+		t.Errorf("want cells of Equal registered values to hold their own values")
+	}
+	h1, _ := Hash(a)
+	SetHashConsing(false)
+	p := Cell(Number(1), Cell(Symbol("a"), Nil))
+	if p == a || !Equal(p, a) || !Equal(a, p) {
+		t.Errorf("want a plain cell Equal to but not the same as an interned cell")
+	}
+	if h2, _ := Hash(p); h1 != h2 {
+		t.Errorf("want the same hash for plain and interned cells. got %v and %v", h1, h2)
+	}
+	SetHashConsing(true)
+	d := Cell(Number(1), Cell(Symbol("a"), Nil))
+	if d == a || !Equal(d, a) || !Equal(a, d) {
+		t.Errorf("want cells interned before and after a toggle to be Equal but not the same")
+	}
+	if h3, _ := Hash(d); h1 != h3 {
+		t.Errorf("want the same hash across a toggle. got %v and %v", h1, h3)
+	}
+}
+
+// quotedData builds a list of n records, like a large quoted data set in
+// which many sublists are the same.
+func quotedData(n int) Value {
+	var l Value = Nil
+	for i := 0; i < n; i++ {
+		l = Cell(Cell(Symbol("point"), Cell(Number(i%10), Cell(String("label"), Nil))), l)
+	}
+	return l
+}
+
+func BenchmarkCell(b *testing.B) {
+	for _, on := range []bool{false, true} {
+		b.Run(fmt.Sprintf("hash-consing=%v", on), func(b *testing.B) {
+			SetHashConsing(on)
+			defer SetHashConsing(false)
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				quotedData(1000)
+			}
+		})
+	}
+}
+
+func BenchmarkCellEqual(b *testing.B) {
+	for _, on := range []bool{false, true} {
+		b.Run(fmt.Sprintf("hash-consing=%v", on), func(b *testing.B) {
+			SetHashConsing(on)
+			defer SetHashConsing(false)
+			x, y := quotedData(1000), quotedData(1000)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if !Equal(x, y) {
+					b.Fatal("want equal")
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package object
+
+import "fmt"
+
+// Channel passes values between goroutines.
+type Channel chan Value
+
+func NewChannel(size int) Channel {
+	return make(Channel, size)
+}
+
+func (c Channel) First() Value {
+	return Nil
+}
+
+func (c Channel) Rest() Value {
+	return Nil
+}
+
+func (c Channel) Type() Type {
+	return CHANNEL
+}
+
+func (c Channel) String() string {
+	return fmt.Sprintf("<channel %v/%v>", len(c), cap(c))
+}
+
+// Equal reports whether v is the same channel.
+func (c Channel) Equal(v Value) bool {
+	o, ok := v.(Channel)
+	return ok && o == c
+}
+
+// Hash returns false. Channels are compared by identity and cannot be hashed.
+func (c Channel) Hash() (uint64, bool) {
+	return 0, false
+}
@@ -0,0 +1,55 @@
+package object
+
+import (
+	"fmt"
+	"unicode"
+)
+
+// Char is a single Unicode code point. It is what First returns for
+// symbols and strings. Chars are atoms.
+type Char rune
+
+// CharNames are the names of characters which print as a name rather than
+// as themselves, such as #\space.
+var CharNames = map[string]Char{
+	"space":   ' ',
+	"newline": '\n',
+	"tab":     '\t',
+	"return":  '\r',
+	"nul":     0,
+}
+
+func (c Char) First() Value {
+	return Nil
+}
+
+func (c Char) Rest() Value {
+	return Nil
+}
+
+func (c Char) Type() Type {
+	return CHAR
+}
+
+// String returns the literal syntax of the character, so that it reads back
+// as the same character.
+func (c Char) String() string {
+	for name, n := range CharNames {
+		if n == c {
+			return `#\` + name
+		}
+	}
+	if !unicode.IsPrint(rune(c)) {
+		return fmt.Sprintf(`#\u%04x`, rune(c))
+	}
+	return `#\` + string(c)
+}
+
+func (c Char) Equal(v Value) bool {
+	o, ok := v.(Char)
+	return ok && o == c
+}
+
+func (c Char) Hash() (uint64, bool) {
+	return hashString(CHAR, string(rune(c))), true
+}
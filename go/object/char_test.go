@@ -0,0 +1,29 @@
+package object
+
+import "testing"
+
+func TestChar(t *testing.T) {
+	tests := []struct {
+		char   Char
+		string string
+	}{
+		{'a', `#\a`},
+		{'é', `#\é`},
+		{'(', `#\(`},
+		{' ', `#\space`},
+		{'\n', `#\newline`},
+		{'\t', `#\tab`},
+		{0, `#\nul`},
+		{0xa0, `#\u00a0`},
+		{0x7f, `#\u007f`},
+	}
+
+	for _, tt := range tests {
+		if got := tt.char.String(); got != tt.string {
+			t.Errorf("given %U. want string %q. got %q", rune(tt.char), tt.string, got)
+		}
+		if tt.char.First() != Nil || tt.char.Rest() != Nil {
+			t.Errorf("given %U. want an atom", rune(tt.char))
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package object
+
+import (
+	"math"
+	"strings"
+)
+
+// Compare orders any two values, returning -1, 0 or 1. Values are ordered
+// first by kind: numbers, characters, symbols, strings, bytes, lists,
+// vectors and then every other type by type name. Within a kind numbers
+// are ordered by value, with an exact number before an equal float and
+// NaN last; characters, symbols, strings and bytes by code point or byte;
+// lists, forcing the tails of streams, and vectors lexicographically, with
+// () before any other list; and other values by their printed form. Compare returns 0 for Equal values.
+func Compare(a, b Value) int {
+	if r, s := rank(a), rank(b); r != s {
+		return sign(r - s)
+	}
+	switch a.Type() {
+	case NUMBER, BIGINT, RATIO, FLOAT:
+		return compareNumbers(a, b)
+	case CHAR:
+		return sign(int(a.(Char)) - int(b.(Char)))
+	case SYMBOL:
+		return strings.Compare(string(a.(Symbol)), string(b.(Symbol)))
+	case STRING:
+		return strings.Compare(string(a.(String)), string(b.(String)))
+	case BYTES:
+		return strings.Compare(string(a.(Bytes)), string(b.(Bytes)))
+	case NIL, CELL:
+		switch {
+		case a.Type() == NIL && b.Type() == NIL:
+			return 0
+		case a.Type() == NIL:
+			return -1
+		case b.Type() == NIL:
+			return 1
+		}
+		if c := Compare(a.First(), b.First()); c != 0 {
+			return c
+		}
+		return Compare(forced(a.Rest()), forced(b.Rest()))
+	case VECTOR:
+		v, w := a.(*Vector), b.(*Vector)
+		for i := 0; i < len(v.elems) && i < len(w.elems); i++ {
+			if c := Compare(v.elems[i], w.elems[i]); c != 0 {
+				return c
+			}
+		}
+		return sign(len(v.elems) - len(w.elems))
+	}
+	if a.Type() != b.Type() {
+		return strings.Compare(string(a.Type()), string(b.Type()))
+	}
+	if Equal(a, b) {
+		return 0
+	}
+	return strings.Compare(Print(a), Print(b))
+}
+
+// rank is the position of the kind of a value in the order.
+func rank(v Value) int {
+	switch v.Type() {
+	case NUMBER, BIGINT, RATIO, FLOAT:
+		return 0
+	case CHAR:
+		return 1
+	case SYMBOL:
+		return 2
+	case STRING:
+		return 3
+	case BYTES:
+		return 4
+	case NIL, CELL:
+		return 5
+	case VECTOR:
+		return 6
+	default:
+		return 7
+	}
+}
+
+func compareNumbers(a, b Value) int {
+	aNaN, bNaN := isNaN(a), isNaN(b)
+	switch {
+	case aNaN && bNaN:
+		return 0
+	case aNaN:
+		return 1
+	case bNaN:
+		return -1
+	}
+	if c := Cmp(a, b); c != 0 {
+		return c
+	}
+	// Equal in value, so an exact number goes before a float.
+	switch {
+	case IsExact(a) == IsExact(b):
+		return 0
+	case IsExact(a):
+		return -1
+	default:
+		return 1
+	}
+}
+
+func isNaN(v Value) bool {
+	f, ok := v.(Float)
+	return ok && math.IsNaN(float64(f))
+}
+
+func sign(n int) int {
+	switch {
+	case n < 0:
+		return -1
+	case n > 0:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,63 @@
+package object
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	big1 := Integer(new(big.Int).Lsh(big.NewInt(1), 70))
+	list := func(values ...Value) Value {
+		var l Value = Nil
+		for i := len(values) - 1; i >= 0; i-- {
+			l = Cell(values[i], l)
+		}
+		return l
+	}
+	stream := Cell(Number(1), Delay(func() Value { return list(Number(2)) }))
+	tests := []struct {
+		a, b Value
+		want int
+	}{
+		{Number(1), Number(2), -1},
+		{Number(2), Number(2), 0},
+		{big1, Number(2), 1},
+		{Rational(big.NewRat(1, 2)), Float(0.75), -1},
+		{Rational(big.NewRat(1, 2)), Float(0.5), -1},
+		{Float(0.5), Rational(big.NewRat(1, 2)), 1},
+		{Float(math.NaN()), Float(math.Inf(1)), 1},
+		{Float(math.NaN()), Float(math.NaN()), 0},
+		{Number(100), Char('a'), -1},
+		{Char('a'), Char('b'), -1},
+		{Char('z'), Symbol("a"), -1},
+		{Symbol("b"), Symbol("a"), 1},
+		{Symbol("z"), Symbol("é"), -1},
+		{Symbol("z"), String("a"), -1},
+		{String("a"), Bytes("a"), -1},
+		{String(`a"b`), String("a#"), -1},
+		{Bytes("\x09"), Bytes("\x0a"), -1},
+		{Bytes("\x02"), Bytes("\x10\x00"), -1},
+		{Bytes("z"), Nil, -1},
+		{Nil, list(Number(1)), -1},
+		{list(Number(1), Number(2)), list(Number(1), Number(3)), -1},
+		{list(Number(1), Number(2)), list(Number(1)), 1},
+		{list(Number(1), Number(2)), list(Number(1), Number(2)), 0},
+		{stream, list(Number(1), Number(2)), 0},
+		{stream, list(Number(1), Number(3)), -1},
+		{list(Symbol("z")), NewVector(nil), -1},
+		{NewVector([]Value{Number(1)}), NewVector([]Value{Number(1), Number(0)}), -1},
+		{NewVector([]Value{Number(2)}), NewVector([]Value{Number(1), Number(0)}), 1},
+		{NewVector(nil), EmptyMap, -1},
+		{EmptyMap, EmptySet, -1},
+	}
+
+	for _, tt := range tests {
+		if got := Compare(tt.a, tt.b); got != tt.want {
+			t.Errorf("given %v and %v. want %v. got %v", tt.a, tt.b, tt.want, got)
+		}
+		if got := Compare(tt.b, tt.a); got != -tt.want {
+			t.Errorf("given %v and %v. want %v. got %v", tt.b, tt.a, -tt.want, got)
+		}
+	}
+}
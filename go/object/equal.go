@@ -0,0 +1,96 @@
+package object
+
+import (
+	"hash/fnv"
+	"reflect"
+)
+
+// Equaler is implemented by values which compare structurally. Every
+// builtin value type implements it.
+type Equaler interface {
+	// Equal reports whether v is the same value.
+	Equal(v Value) bool
+}
+
+// Hasher is implemented by values which can be hashed. Every builtin value
+// type implements it.
+type Hasher interface {
+	// Hash returns a hash which is the same for Equal values, and false if
+	// the value cannot be hashed.
+	Hash() (uint64, bool)
+}
+
+// Equal reports whether two values are the same. Cells, vectors, maps,
+// sets and records are compared structurally and numbers by value. An
+// exact number never equals a float, so 1/2 and 0.5 are different values.
+// Registered types are compared with their Equal hook, then with an Equal
+// method. Other values are compared by identity.
+func Equal(a, b Value) bool {
+	if info, ok := registered(a.Type()); ok && info.Equal != nil {
+		return a.Type() == b.Type() && info.Equal(a, b)
+	}
+	if e, ok := a.(Equaler); ok {
+		return e.Equal(b)
+	}
+	return Identical(a, b)
+}
+
+// Hash returns a hash of v which is the same for values which are Equal,
+// and false if v cannot be hashed. Symbols, strings, characters, bytes,
+// numbers, nil, errors and cells, vectors, maps, sets and records of those
+// can be hashed, as can registered types with a Hash hook or method.
+func Hash(v Value) (uint64, bool) {
+	if info, ok := registered(v.Type()); ok && info.Hash != nil {
+		return info.Hash(v), true
+	}
+	if h, ok := v.(Hasher); ok {
+		return h.Hash()
+	}
+	return 0, false
+}
+
+// Identical reports whether two values are the same object, without
+// looking inside vectors, maps, sets or records. Numbers, symbols, strings,
+// characters and bytes are identified by their value. Cells have no
+// identity of their own, so cells are identical when their elements are.
+func Identical(a, b Value) bool {
+	if IsNumber(a) {
+		return IsNumber(b) && Equal(a, b)
+	}
+	switch a.Type() {
+	case CELL, QUOTED, UNQUOTED:
+		if c, ok := a.(*cell); ok && c == b {
+			return true
+		}
+		return a.Type() == b.Type() && Identical(a.First(), b.First()) && Identical(a.Rest(), b.Rest())
+	}
+	if !reflect.TypeOf(a).Comparable() || !reflect.TypeOf(b).Comparable() {
+		return false
+	}
+	return a == b
+}
+
+func hashString(typ Type, s string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(typ))
+	h.Write([]byte{0})
+	h.Write([]byte(s))
+	return h.Sum64()
+}
+
+func combine(h, x uint64) uint64 {
+	return (h ^ x) * 1099511628211
+}
+
+// hashAll combines the hashes of values in order, and returns false if any
+// cannot be hashed.
+func hashAll(h uint64, values ...Value) (uint64, bool) {
+	for _, v := range values {
+		vh, ok := Hash(v)
+		if !ok {
+			return 0, false
+		}
+		h = combine(h, vh)
+	}
+	return h, true
+}
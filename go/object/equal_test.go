@@ -0,0 +1,101 @@
+package object
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestEqualHash(t *testing.T) {
+	big1 := Integer(new(big.Int).Lsh(big.NewInt(1), 70))
+	big2 := Integer(new(big.Int).Lsh(big.NewInt(1), 70))
+	m1, _ := EmptyMap.Assoc(Symbol("a"), Number(1))
+	m1, _ = m1.Assoc(Symbol("b"), Number(2))
+	m2, _ := EmptyMap.Assoc(Symbol("b"), Number(2))
+	m2, _ = m2.Assoc(Symbol("a"), Number(1))
+	tests := []struct {
+		a, b  Value
+		equal bool
+	}{
+		{Symbol("a"), Symbol("a"), true},
+		{Symbol("a"), String("a"), false},
+		{Char('a'), Symbol("a"), false},
+		{Number(1), Number(1), true},
+		{big1, big2, true},
+		{Rational(big.NewRat(1, 2)), Rational(big.NewRat(2, 4)), true},
+		{Rational(big.NewRat(1, 2)), Float(0.5), false},
+		{Float(0), Float(math.Copysign(0, -1)), true},
+		{Cell(Number(1), Cell(Symbol("a"), Nil)), Cell(Number(1), Cell(Symbol("a"), Nil)), true},
+		{NewVector([]Value{Number(1)}), NewVector([]Value{Number(1)}), true},
+		{NewVector([]Value{Number(1)}), Cell(Number(1), Nil), false},
+		{m1, m2, true},
+		{m1, EmptyMap, false},
+		{Nil, Nil, true},
+	}
+
+	for _, tt := range tests {
+		if got := Equal(tt.a, tt.b); got != tt.equal {
+			t.Errorf("given %v and %v. want equal %v. got %v", tt.a, tt.b, tt.equal, got)
+		}
+		ha, oka := Hash(tt.a)
+		hb, okb := Hash(tt.b)
+		if !oka || !okb {
+			t.Errorf("given %v and %v. want hashable", tt.a, tt.b)
+		}
+		if tt.equal && ha != hb {
+			t.Errorf("given %v and %v. want equal hashes", tt.a, tt.b)
+		}
+	}
+	if _, ok := Hash(NewVector([]Value{NewChannel(0)})); ok {
+		t.Errorf("want a vector of channels to be unhashable")
+	}
+}
+
+func TestEveryTypeEqualHash(t *testing.T) {
+	point := NewRecordType("point", []string{"x"})
+	values := []Value{
+		Symbol("a"), String("a"), Char('a'), Bytes("a"), Number(1),
+		Integer(new(big.Int).Lsh(big.NewInt(1), 70)), Rational(big.NewRat(1, 2)),
+		Float(1.5), Cell(Number(1), Nil), NewVector(nil), EmptyMap, EmptySet,
+		point.New([]Value{Number(1)}), Nil, Quoted(Symbol("a")),
+		Unquoted(Symbol("a")), Error("a"), Delay(func() Value { return Nil }),
+		Go(func() Value { return Nil }), NewChannel(0), NewActor(),
+	}
+	for _, v := range values {
+		if _, ok := v.(Equaler); !ok {
+			t.Errorf("%v does not implement Equaler", v.Type())
+		}
+		if _, ok := v.(Hasher); !ok {
+			t.Errorf("%v does not implement Hasher", v.Type())
+		}
+		if !Equal(v, v) || !Identical(v, v) {
+			t.Errorf("want %v equal to itself", v)
+		}
+	}
+}
+
+func TestIdentical(t *testing.T) {
+	tests := []struct {
+		a, b      Value
+		identical bool
+		equal     bool
+	}{
+		{Symbol("a"), Symbol("a"), true, true},
+		{Number(1), Number(1), true, true},
+		{Number(1), Float(1), false, false},
+		{Integer(new(big.Int).Lsh(big.NewInt(1), 70)), Integer(new(big.Int).Lsh(big.NewInt(1), 70)), true, true},
+		{String("a"), String("a"), true, true},
+		{Cell(Symbol("a"), Nil), Cell(Symbol("a"), Nil), true, true},
+		{Cell(NewVector(nil), Nil), Cell(NewVector(nil), Nil), false, true},
+		{NewVector(nil), NewVector(nil), false, true},
+		{NewChannel(0), NewChannel(0), false, false},
+	}
+	for _, tt := range tests {
+		if got := Identical(tt.a, tt.b); got != tt.identical {
+			t.Errorf("given %v and %v. want identical %v. got %v", tt.a, tt.b, tt.identical, got)
+		}
+		if got := Equal(tt.a, tt.b); got != tt.equal {
+			t.Errorf("given %v and %v. want equal %v. got %v", tt.a, tt.b, tt.equal, got)
+		}
+	}
+}
@@ -17,3 +17,12 @@ func (e Error) Type() Type {
 func (e Error) String() string {
 	return string("<error: " + e + ">")
 }
+
+func (e Error) Equal(v Value) bool {
+	o, ok := v.(Error)
+	return ok && o == e
+}
+
+func (e Error) Hash() (uint64, bool) {
+	return hashString(ERROR, string(e)), true
+}
@@ -0,0 +1,53 @@
+package object
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Float is an IEEE 754 double. Floats are atoms.
+type Float float64
+
+func (f Float) First() Value {
+	return Nil
+}
+
+func (f Float) Rest() Value {
+	return Nil
+}
+
+func (f Float) Type() Type {
+	return FLOAT
+}
+
+// String returns the shortest representation which parses back to f. It
+// always contains a decimal point or an exponent, so that it is not read
+// back as an integer.
+func (f Float) String() string {
+	s := strconv.FormatFloat(float64(f), 'g', -1, 64)
+	if !strings.ContainsAny(s, ".eEnN") {
+		s += ".0"
+	}
+	return s
+}
+
+// IsFinite reports whether f is neither infinite nor NaN.
+func (f Float) IsFinite() bool {
+	return !math.IsInf(float64(f), 0) && !math.IsNaN(float64(f))
+}
+
+// Equal reports whether v is a Float with the same value. Floats never
+// equal exact numbers, and NaN equals nothing.
+func (f Float) Equal(v Value) bool {
+	o, ok := v.(Float)
+	return ok && o == f
+}
+
+func (f Float) Hash() (uint64, bool) {
+	if f == 0 {
+		// 0.0 and -0.0 are equal.
+		f = 0
+	}
+	return hashString(FLOAT, strconv.FormatUint(math.Float64bits(float64(f)), 16)), true
+}
@@ -0,0 +1,77 @@
+package object
+
+import "fmt"
+
+// Future is the result of a computation running on another goroutine.
+type Future struct {
+	done  chan struct{}
+	value Value
+}
+
+// Go runs fn on a new goroutine and returns its future result.
+func Go(fn func() Value) *Future {
+	f := &Future{done: make(chan struct{})}
+	go func() {
+		defer close(f.done)
+		f.value = Protect(fn)
+	}()
+	return f
+}
+
+// Protect calls fn and returns its value, or an error if fn panics. A panic
+// on a goroutine of its own would otherwise stop the whole program.
+func Protect(fn func() Value) (value Value) {
+	defer func() {
+		if r := recover(); r != nil {
+			value = Error(fmt.Sprintf("panic: %v", r))
+		}
+	}()
+	value = fn()
+	if value == nil {
+		value = Nil
+	}
+	return value
+}
+
+// Await blocks until the computation has finished and returns its value.
+func (f *Future) Await() Value {
+	<-f.done
+	return f.value
+}
+
+// Done is closed when the computation has finished.
+func (f *Future) Done() <-chan struct{} {
+	return f.done
+}
+
+func (f *Future) First() Value {
+	return Nil
+}
+
+func (f *Future) Rest() Value {
+	return Nil
+}
+
+func (f *Future) Type() Type {
+	return FUTURE
+}
+
+func (f *Future) String() string {
+	select {
+	case <-f.done:
+		return fmt.Sprintf("<future %v>", Print(f.value))
+	default:
+		return "<future>"
+	}
+}
+
+// Equal reports whether v is the same future.
+func (f *Future) Equal(v Value) bool {
+	o, ok := v.(*Future)
+	return ok && o == f
+}
+
+// Hash returns false. Futures are compared by identity and cannot be hashed.
+func (f *Future) Hash() (uint64, bool) {
+	return 0, false
+}
@@ -0,0 +1,17 @@
+package object
+
+import "testing"
+
+func TestGoRecovers(t *testing.T) {
+	f := Go(func() Value { panic("boom") })
+	got := f.Await()
+	if got != Error("panic: boom") {
+		t.Errorf("want panic: boom error. got %v", got)
+	}
+
+	a := NewActor()
+	a.Start(func() Value { panic("boom") })
+	if got := a.Await(); got.Type() != ERROR {
+		t.Errorf("want error from actor. got %v", got)
+	}
+}
@@ -0,0 +1,298 @@
+package object
+
+import (
+	"math/bits"
+	"strings"
+)
+
+// Map is a persistent hash map: a hash array mapped trie keyed by any value
+// which can be hashed. Updates return a new map which shares structure with
+// the original, which is unchanged. As a list its First is an entry
+// (key . value) and its Rest is the map of the remaining entries.
+type Map struct {
+	root *hamtNode
+	size int
+}
+
+// hamtNode holds the children present among 32 slots, one for each 5 bit
+// chunk of a hash at the node's depth. Children are stored densely in slot
+// order and the bitmap records which slots are present.
+type hamtNode struct {
+	bitmap   uint32
+	children []hamtChild
+}
+
+// hamtChild is either a subtree or a leaf of entries whose keys share the
+// full hash.
+type hamtChild struct {
+	node    *hamtNode
+	hash    uint64
+	entries []mapEntry
+}
+
+type mapEntry struct {
+	key, value Value
+}
+
+const hamtBits = 5
+
+// EmptyMap is the map with no entries.
+var EmptyMap = &Map{}
+
+// Len returns the number of entries.
+func (m *Map) Len() int {
+	return m.size
+}
+
+// Get returns the value for key and whether it is present.
+func (m *Map) Get(key Value) (Value, bool) {
+	hash, ok := Hash(key)
+	if !ok {
+		return nil, false
+	}
+	for n, shift := m.root, 0; n != nil; shift += hamtBits {
+		bit := uint32(1) << ((hash >> shift) & 31)
+		if n.bitmap&bit == 0 {
+			return nil, false
+		}
+		c := n.children[bits.OnesCount32(n.bitmap&(bit-1))]
+		if c.node != nil {
+			n = c.node
+			continue
+		}
+		if c.hash == hash {
+			for _, e := range c.entries {
+				if Equal(e.key, key) {
+					return e.value, true
+				}
+			}
+		}
+		return nil, false
+	}
+	return nil, false
+}
+
+// Assoc returns a map with key bound to value, and false if key cannot be
+// hashed.
+func (m *Map) Assoc(key, value Value) (*Map, bool) {
+	hash, ok := Hash(key)
+	if !ok {
+		return nil, false
+	}
+	root, added := m.root.assoc(0, hash, mapEntry{key, value})
+	size := m.size
+	if added {
+		size++
+	}
+	return &Map{root, size}, true
+}
+
+// Dissoc returns a map without key.
+func (m *Map) Dissoc(key Value) *Map {
+	hash, ok := Hash(key)
+	if !ok {
+		return m
+	}
+	root, removed := m.root.dissoc(0, hash, key)
+	if !removed {
+		return m
+	}
+	return &Map{root, m.size - 1}
+}
+
+// Range calls f for each entry until it returns false.
+func (m *Map) Range(f func(key, value Value) bool) {
+	m.root.each(f)
+}
+
+func (m *Map) First() Value {
+	var first Value = Nil
+	m.Range(func(k, v Value) bool {
+		first = Cell(k, v)
+		return false
+	})
+	return first
+}
+
+func (m *Map) Rest() Value {
+	if m.size < 2 {
+		return Nil
+	}
+	return m.Dissoc(m.First().First())
+}
+
+func (m *Map) Type() Type {
+	return MAP
+}
+
+func (m *Map) String() string {
+	var b strings.Builder
+	b.WriteString("{")
+	first := true
+	m.Range(func(k, v Value) bool {
+		if !first {
+			b.WriteString(" ")
+		}
+		first = false
+		b.WriteString(Print(k))
+		b.WriteString(" ")
+		b.WriteString(Print(v))
+		return true
+	})
+	b.WriteString("}")
+	return b.String()
+}
+
+// assoc returns a copy of the node with the entry added or replaced, and
+// whether it was added. A nil node is empty.
+func (n *hamtNode) assoc(shift uint, hash uint64, e mapEntry) (*hamtNode, bool) {
+	if n == nil {
+		n = &hamtNode{}
+	}
+	bit := uint32(1) << ((hash >> shift) & 31)
+	i := bits.OnesCount32(n.bitmap & (bit - 1))
+	if n.bitmap&bit == 0 {
+		children := make([]hamtChild, len(n.children)+1)
+		copy(children, n.children[:i])
+		children[i] = hamtChild{hash: hash, entries: []mapEntry{e}}
+		copy(children[i+1:], n.children[i:])
+		return &hamtNode{n.bitmap | bit, children}, true
+	}
+	c := n.children[i]
+	var added bool
+	switch {
+	case c.node != nil:
+		c.node, added = c.node.assoc(shift+hamtBits, hash, e)
+	case c.hash == hash:
+		entries := make([]mapEntry, len(c.entries), len(c.entries)+1)
+		copy(entries, c.entries)
+		added = true
+		for j := range entries {
+			if Equal(entries[j].key, e.key) {
+				entries[j] = e
+				added = false
+				break
+			}
+		}
+		if added {
+			entries = append(entries, e)
+		}
+		c.entries = entries
+	default:
+		// Two hashes share this slot, so push both down a level. They
+		// differ somewhere, so they eventually land in different slots.
+		var node *hamtNode
+		for _, old := range c.entries {
+			node, _ = node.assoc(shift+hamtBits, c.hash, old)
+		}
+		node, _ = node.assoc(shift+hamtBits, hash, e)
+		c = hamtChild{node: node}
+		added = true
+	}
+	children := make([]hamtChild, len(n.children))
+	copy(children, n.children)
+	children[i] = c
+	return &hamtNode{n.bitmap, children}, added
+}
+
+// dissoc returns a copy of the node without key, and whether it was
+// present. Empty nodes are returned as nil.
+func (n *hamtNode) dissoc(shift uint, hash uint64, key Value) (*hamtNode, bool) {
+	if n == nil {
+		return nil, false
+	}
+	bit := uint32(1) << ((hash >> shift) & 31)
+	if n.bitmap&bit == 0 {
+		return n, false
+	}
+	i := bits.OnesCount32(n.bitmap & (bit - 1))
+	c := n.children[i]
+	switch {
+	case c.node != nil:
+		node, removed := c.node.dissoc(shift+hamtBits, hash, key)
+		if !removed {
+			return n, false
+		}
+		c.node = node
+	case c.hash == hash:
+		j := -1
+		for k := range c.entries {
+			if Equal(c.entries[k].key, key) {
+				j = k
+				break
+			}
+		}
+		if j < 0 {
+			return n, false
+		}
+		entries := make([]mapEntry, 0, len(c.entries)-1)
+		entries = append(entries, c.entries[:j]...)
+		entries = append(entries, c.entries[j+1:]...)
+		c.entries = entries
+	default:
+		return n, false
+	}
+	if c.node == nil && len(c.entries) == 0 {
+		if n.bitmap == bit {
+			return nil, true
+		}
+		children := make([]hamtChild, 0, len(n.children)-1)
+		children = append(children, n.children[:i]...)
+		children = append(children, n.children[i+1:]...)
+		return &hamtNode{n.bitmap &^ bit, children}, true
+	}
+	children := make([]hamtChild, len(n.children))
+	copy(children, n.children)
+	children[i] = c
+	return &hamtNode{n.bitmap, children}, true
+}
+
+func (n *hamtNode) each(f func(key, value Value) bool) bool {
+	if n == nil {
+		return true
+	}
+	for _, c := range n.children {
+		if c.node != nil {
+			if !c.node.each(f) {
+				return false
+			}
+			continue
+		}
+		for _, e := range c.entries {
+			if !f(e.key, e.value) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Equal reports whether v is a map with the same keys bound to equal
+// values, regardless of the order in which they were added.
+func (m *Map) Equal(v Value) bool {
+	o, ok := v.(*Map)
+	if !ok || m.Len() != o.Len() {
+		return false
+	}
+	equal := true
+	m.Range(func(k, v Value) bool {
+		w, ok := o.Get(k)
+		equal = ok && Equal(v, w)
+		return equal
+	})
+	return equal
+}
+
+// Hash combines the entries independently of their order.
+func (m *Map) Hash() (uint64, bool) {
+	h := hashString(MAP, "")
+	ok := true
+	m.Range(func(k, v Value) bool {
+		var kh, vh uint64
+		kh, _ = Hash(k)
+		vh, ok = Hash(v)
+		h += combine(kh, vh)
+		return ok
+	})
+	return h, ok
+}
@@ -0,0 +1,99 @@
+package object
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestMap(t *testing.T) {
+	m := EmptyMap
+	if m.First() != Nil || m.Rest() != Nil || m.String() != "{}" {
+		t.Errorf("want an empty map. got %v", m)
+	}
+	m, _ = m.Assoc(Symbol("a"), Number(1))
+	if got := m.String(); got != "{a 1}" {
+		t.Errorf("want {a 1}. got %v", got)
+	}
+	if got := m.First().String(); got != "(a 1)" {
+		t.Errorf("want first (a 1). got %v", got)
+	}
+	n, _ := m.Assoc(Symbol("a"), Number(2))
+	if v, _ := m.Get(Symbol("a")); v != Number(1) {
+		t.Errorf("assoc modified the original: %v", m)
+	}
+	if v, _ := n.Get(Symbol("a")); v != Number(2) || n.Len() != 1 {
+		t.Errorf("want {a 2}. got %v", n)
+	}
+	if _, ok := m.Assoc(Symbol("f"), Number(1)); !ok {
+		t.Errorf("want symbols to be hashable")
+	}
+	if _, ok := m.Assoc(NewChannel(0), Number(1)); ok {
+		t.Errorf("want channels to be unhashable")
+	}
+	if d := m.Dissoc(Symbol("a")); d.Len() != 0 || m.Len() != 1 {
+		t.Errorf("want dissoc to return an empty map and leave the original")
+	}
+}
+
+func TestMapRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	m := EmptyMap
+	want := map[Number]Number{}
+	for i := 0; i < 5000; i++ {
+		k, v := Number(r.Intn(1000)), Number(r.Int63())
+		if r.Intn(3) == 0 {
+			m = m.Dissoc(k)
+			delete(want, k)
+		} else {
+			m, _ = m.Assoc(k, v)
+			want[k] = v
+		}
+		if m.Len() != len(want) {
+			t.Fatalf("step %v: want length %v. got %v", i, len(want), m.Len())
+		}
+	}
+	for k, v := range want {
+		if got, ok := m.Get(k); !ok || got != v {
+			t.Errorf("want %v for %v. got %v", v, k, got)
+		}
+	}
+	seen := 0
+	m.Range(func(k, v Value) bool {
+		seen++
+		if want[k.(Number)] != v {
+			t.Errorf("range gave %v for %v", v, k)
+		}
+		return true
+	})
+	if seen != len(want) {
+		t.Errorf("range visited %v entries. want %v", seen, len(want))
+	}
+}
+
+func TestHamtCollisions(t *testing.T) {
+	var root *hamtNode
+	// The same full hash, then hashes which only differ in their top bits.
+	hashes := []uint64{7, 7, 7 | 1<<63, 7 | 1<<62}
+	for i, h := range hashes {
+		var added bool
+		root, added = root.assoc(0, h, mapEntry{Number(i), Number(i)})
+		if !added {
+			t.Errorf("want entry %v added", i)
+		}
+	}
+	count := 0
+	root.each(func(k, v Value) bool { count++; return true })
+	if count != len(hashes) {
+		t.Errorf("want %v entries. got %v", len(hashes), count)
+	}
+	for i, h := range hashes {
+		var removed bool
+		root, removed = root.dissoc(0, h, Number(i))
+		if !removed {
+			t.Errorf("want entry %v removed", i)
+		}
+	}
+	if root != nil {
+		t.Errorf("want empty root. got %+v", root)
+	}
+}
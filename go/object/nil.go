@@ -19,3 +19,11 @@ func (n n) Type() Type {
 func (n n) String() string {
 	return "()"
 }
+
+func (n n) Equal(v Value) bool {
+	return v.Type() == NIL
+}
+
+func (n n) Hash() (uint64, bool) {
+	return hashString(NIL, ""), true
+}
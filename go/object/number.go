@@ -1,6 +1,9 @@
 package object
 
-import "fmt"
+import (
+	"fmt"
+	"math"
+)
 
 type Number int64
 
@@ -19,3 +22,68 @@ func (n Number) Type() Type {
 func (n Number) String() string {
 	return fmt.Sprintf("%v", int64(n))
 }
+
+// Add returns n + m and false if the sum overflows.
+func (n Number) Add(m Number) (Number, bool) {
+	sum := n + m
+	if (sum > n) != (m > 0) {
+		return 0, false
+	}
+	return sum, true
+}
+
+// Sub returns n - m and false if the difference overflows.
+func (n Number) Sub(m Number) (Number, bool) {
+	diff := n - m
+	if (diff < n) != (m > 0) {
+		return 0, false
+	}
+	return diff, true
+}
+
+// Mul returns n * m and false if the product overflows.
+func (n Number) Mul(m Number) (Number, bool) {
+	if n == 0 || m == 0 {
+		return 0, true
+	}
+	product := n * m
+	if product/m != n || (n == -1 && m == math.MinInt64) || (m == -1 && n == math.MinInt64) {
+		return 0, false
+	}
+	return product, true
+}
+
+// Quo returns n / m truncated towards zero and false if m is zero or the
+// quotient overflows.
+func (n Number) Quo(m Number) (Number, bool) {
+	if m == 0 || (n == math.MinInt64 && m == -1) {
+		return 0, false
+	}
+	return n / m, true
+}
+
+// Mod returns n modulo m, which has the sign of m, and false if m is zero.
+func (n Number) Mod(m Number) (Number, bool) {
+	if m == 0 {
+		return 0, false
+	}
+	if m == -1 {
+		return 0, true
+	}
+	mod := n % m
+	if mod != 0 && (mod < 0) != (m < 0) {
+		mod += m
+	}
+	return mod, true
+}
+
+// Equal reports whether v is the same Number. Integers have a single
+// representation, so a Number never equals a BigInt.
+func (n Number) Equal(v Value) bool {
+	o, ok := v.(Number)
+	return ok && o == n
+}
+
+func (n Number) Hash() (uint64, bool) {
+	return hashString(NUMBER, n.String()), true
+}
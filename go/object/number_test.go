@@ -1,6 +1,9 @@
 package object
 
-import "testing"
+import (
+	"math"
+	"testing"
+)
 
 func TestNumber(t *testing.T) {
 	tests := []struct {
@@ -45,3 +48,47 @@ func TestNumber(t *testing.T) {
 		}
 	}
 }
+
+func TestNumberArith(t *testing.T) {
+	const max, min = Number(math.MaxInt64), Number(math.MinInt64)
+	tests := []struct {
+		name string
+		op   func(Number, Number) (Number, bool)
+		a, b Number
+		want Number
+		ok   bool
+	}{
+		{"add", Number.Add, 1, 2, 3, true},
+		{"add", Number.Add, -1, -2, -3, true},
+		{"add", Number.Add, max, 1, 0, false},
+		{"add", Number.Add, min, -1, 0, false},
+		{"add", Number.Add, max, min, -1, true},
+		{"sub", Number.Sub, 1, 2, -1, true},
+		{"sub", Number.Sub, min, 1, 0, false},
+		{"sub", Number.Sub, max, -1, 0, false},
+		{"sub", Number.Sub, 0, min, 0, false},
+		{"mul", Number.Mul, -3, 4, -12, true},
+		{"mul", Number.Mul, 0, min, 0, true},
+		{"mul", Number.Mul, max, 2, 0, false},
+		{"mul", Number.Mul, min, -1, 0, false},
+		{"mul", Number.Mul, -1, min, 0, false},
+		{"mul", Number.Mul, -1, max, -max, true},
+		{"quo", Number.Quo, 7, 2, 3, true},
+		{"quo", Number.Quo, -7, 2, -3, true},
+		{"quo", Number.Quo, 1, 0, 0, false},
+		{"quo", Number.Quo, min, -1, 0, false},
+		{"mod", Number.Mod, 7, 3, 1, true},
+		{"mod", Number.Mod, -7, 3, 2, true},
+		{"mod", Number.Mod, 7, -3, -2, true},
+		{"mod", Number.Mod, -6, 3, 0, true},
+		{"mod", Number.Mod, min, -1, 0, true},
+		{"mod", Number.Mod, 1, 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := tt.op(tt.a, tt.b)
+		if ok != tt.ok || got != tt.want {
+			t.Errorf("%v %v %v. want %v %v. got %v %v", tt.name, tt.a, tt.b, tt.want, tt.ok, got, ok)
+		}
+	}
+}
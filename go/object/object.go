@@ -4,8 +4,17 @@ type Type string
 
 const (
 	SYMBOL Type = "SYMBOL"
+	STRING      = "STRING"
+	CHAR        = "CHAR"
+	BYTES       = "BYTES"
 	NUMBER      = "NUMBER"
+	BIGINT      = "BIGINT"
+	RATIO       = "RATIO"
+	FLOAT       = "FLOAT"
 	CELL        = "CELL"
+	VECTOR      = "VECTOR"
+	MAP         = "MAP"
+	SET         = "SET"
 	NIL         = "NIL"
 
 	QUOTED   = "QUOTED"
@@ -13,6 +22,10 @@ const (
 
 	FUNCTION = "FUNCTION"
 	ERROR    = "ERROR"
+	PROMISE  = "PROMISE"
+	FUTURE   = "FUTURE"
+	CHANNEL  = "CHANNEL"
+	ACTOR    = "ACTOR"
 )
 
 type Value interface {
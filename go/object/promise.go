@@ -0,0 +1,77 @@
+package object
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Promise is a value computed on demand. The first Force runs the thunk and
+// later calls return the same result. First and Rest force the promise so
+// that a promise in the rest of a cell reads like the rest of a list.
+type Promise struct {
+	mu    sync.Mutex
+	done  bool
+	thunk func() Value
+	value Value
+}
+
+func Delay(thunk func() Value) *Promise {
+	return &Promise{thunk: thunk}
+}
+
+func (p *Promise) Force() Value {
+	p.mu.Lock()
+	if p.done {
+		defer p.mu.Unlock()
+		return p.value
+	}
+	thunk := p.thunk
+	p.mu.Unlock()
+
+	// The thunk runs unlocked so that it may force the promise itself.
+	// Whichever result is stored first wins.
+	value := thunk()
+	if value == nil {
+		value = Nil
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.done {
+		p.done = true
+		p.value = value
+		p.thunk = nil
+	}
+	return p.value
+}
+
+func (p *Promise) First() Value {
+	return p.Force().First()
+}
+
+func (p *Promise) Rest() Value {
+	return p.Force().Rest()
+}
+
+func (p *Promise) Type() Type {
+	return PROMISE
+}
+
+func (p *Promise) String() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.done {
+		return "<promise>"
+	}
+	return fmt.Sprintf("<promise %v>", Print(p.value))
+}
+
+// Equal reports whether v is the same promise.
+func (p *Promise) Equal(v Value) bool {
+	o, ok := v.(*Promise)
+	return ok && o == p
+}
+
+// Hash returns false. Promises are compared by identity and cannot be hashed.
+func (p *Promise) Hash() (uint64, bool) {
+	return 0, false
+}
@@ -0,0 +1,50 @@
+package object
+
+import "testing"
+
+func TestPromise(t *testing.T) {
+	var calls int
+	p := Delay(func() Value {
+		calls++
+		return Cell(Number(1), Cell(Number(2), Nil))
+	})
+	if got := p.String(); got != "<promise>" {
+		t.Errorf("want unforced string %q. got %q", "<promise>", got)
+	}
+	if calls != 0 {
+		t.Errorf("want no calls before force. got %v", calls)
+	}
+	if got := p.First().String(); got != "1" {
+		t.Errorf("want first %q. got %q", "1", got)
+	}
+	if got := p.Rest().String(); got != "(2)" {
+		t.Errorf("want rest %q. got %q", "(2)", got)
+	}
+	if got := p.Force().String(); got != "(1 2)" {
+		t.Errorf("want force %q. got %q", "(1 2)", got)
+	}
+	if calls != 1 {
+		t.Errorf("want 1 call. got %v", calls)
+	}
+	if got := p.String(); got != "<promise (1 2)>" {
+		t.Errorf("want forced string %q. got %q", "<promise (1 2)>", got)
+	}
+}
+
+func TestPromiseReentrant(t *testing.T) {
+	var p *Promise
+	var depth int
+	p = Delay(func() Value {
+		depth++
+		if depth < 3 {
+			return p.Force()
+		}
+		return Number(depth)
+	})
+	if got := p.Force().String(); got != "3" {
+		t.Errorf("want %q. got %q", "3", got)
+	}
+	if got := p.Force().String(); got != "3" {
+		t.Errorf("want memoized %q. got %q", "3", got)
+	}
+}
@@ -25,5 +25,14 @@ func (q quoted) Type() Type {
 }
 
 func (q quoted) String() string {
-	return "'" + q.value.String()
+	return "'" + Print(q.value)
+}
+
+func (q quoted) Equal(v Value) bool {
+	o, ok := v.(quoted)
+	return ok && Equal(q.value, o.value)
+}
+
+func (q quoted) Hash() (uint64, bool) {
+	return hashAll(hashString(QUOTED, ""), q.value)
 }
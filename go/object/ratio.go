@@ -0,0 +1,52 @@
+package object
+
+import (
+	"math/big"
+)
+
+// Ratio is an exact fraction in lowest terms whose denominator is not 1.
+// Use Rational to construct them.
+type Ratio struct {
+	r *big.Rat
+}
+
+// Rational returns r as an integer if its denominator is 1 or a Ratio
+// otherwise.
+func Rational(r *big.Rat) Value {
+	if r.IsInt() {
+		return Integer(r.Num())
+	}
+	return &Ratio{new(big.Rat).Set(r)}
+}
+
+// Rat returns a copy of the fraction.
+func (r *Ratio) Rat() *big.Rat {
+	return new(big.Rat).Set(r.r)
+}
+
+// First returns the numerator.
+func (r *Ratio) First() Value {
+	return Integer(r.r.Num())
+}
+
+// Rest returns the denominator.
+func (r *Ratio) Rest() Value {
+	return Integer(r.r.Denom())
+}
+
+func (r *Ratio) Type() Type {
+	return RATIO
+}
+
+func (r *Ratio) String() string {
+	return r.r.String()
+}
+
+func (r *Ratio) Equal(v Value) bool {
+	o, ok := v.(*Ratio)
+	return ok && o.r.Cmp(r.r) == 0
+}
+
+func (r *Ratio) Hash() (uint64, bool) {
+	return hashString(RATIO, r.r.String()), true
+}
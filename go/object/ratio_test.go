@@ -0,0 +1,94 @@
+package object
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestRational(t *testing.T) {
+	tests := []struct {
+		num, den int64
+		typ      Type
+		first    string
+		rest     string
+		string   string
+	}{
+		{1, 2, RATIO, "1", "2", "1/2"},
+		{2, -4, RATIO, "-1", "2", "-1/2"},
+		{6, 3, NUMBER, "0", "1", "2"},
+		{0, 5, NUMBER, "0", "0", "0"},
+	}
+
+	for _, tt := range tests {
+		v := Rational(big.NewRat(tt.num, tt.den))
+		if v.Type() != tt.typ {
+			t.Errorf("given %v/%v. want type %v. got %v", tt.num, tt.den, tt.typ, v.Type())
+		}
+		if got := v.First().String(); got != tt.first {
+			t.Errorf("given %v/%v. want first %v. got %v", tt.num, tt.den, tt.first, got)
+		}
+		if got := v.Rest().String(); got != tt.rest {
+			t.Errorf("given %v/%v. want rest %v. got %v", tt.num, tt.den, tt.rest, got)
+		}
+		if got := v.String(); got != tt.string {
+			t.Errorf("given %v/%v. want string %q. got %q", tt.num, tt.den, tt.string, got)
+		}
+	}
+}
+
+func TestFloat(t *testing.T) {
+	tests := []struct {
+		float  Float
+		string string
+		finite bool
+	}{
+		{1.5, "1.5", true},
+		{2, "2.0", true},
+		{-0.125, "-0.125", true},
+		{1e21, "1e+21", true},
+		{Float(math.Inf(1)), "+Inf", false},
+		{Float(math.NaN()), "NaN", false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.float.String(); got != tt.string {
+			t.Errorf("given %v. want string %q. got %q", float64(tt.float), tt.string, got)
+		}
+		if got := tt.float.IsFinite(); got != tt.finite {
+			t.Errorf("given %v. want finite %v. got %v", float64(tt.float), tt.finite, got)
+		}
+	}
+}
+
+func TestNumericTower(t *testing.T) {
+	half := Rational(big.NewRat(1, 2))
+	tests := []struct {
+		name string
+		got  Value
+		typ  Type
+		want string
+	}{
+		{"integer plus ratio", Add(Number(1), half), RATIO, "3/2"},
+		{"ratio plus ratio", Add(half, half), NUMBER, "1"},
+		{"ratio times float", Mul(half, Float(3)), FLOAT, "1.5"},
+		{"big integer minus float", Sub(Integer(new(big.Int).Lsh(big.NewInt(1), 70)), Float(0)), FLOAT, "1.1805916207174113e+21"},
+		{"exact quotient", first(Quo(Number(1), Number(3))), RATIO, "1/3"},
+		{"float quotient", first(Quo(Float(1), Number(4))), FLOAT, "0.25"},
+	}
+	for _, tt := range tests {
+		if tt.got.Type() != tt.typ || tt.got.String() != tt.want {
+			t.Errorf("%v: want %v %v. got %v %v", tt.name, tt.typ, tt.want, tt.got.Type(), tt.got)
+		}
+	}
+	if Cmp(half, Float(0.5)) != 0 || Cmp(Rational(big.NewRat(1, 3)), Float(0.3333333333333333)) != 1 {
+		t.Errorf("want exact comparison between ratios and floats")
+	}
+	if Cmp(Float(math.Inf(1)), Integer(new(big.Int).Lsh(big.NewInt(1), 2000))) != 1 {
+		t.Errorf("want infinity above every integer")
+	}
+}
+
+func first(v Value, _ bool) Value {
+	return v
+}
@@ -0,0 +1,110 @@
+package object
+
+import (
+	"strings"
+)
+
+// RecordType describes a user defined record: its name and the names of
+// its fields in order. Records of different RecordTypes are never equal,
+// even when the types share a name.
+type RecordType struct {
+	Name   string
+	Fields []string
+}
+
+// NewRecordType returns a new record type.
+func NewRecordType(name string, fields []string) *RecordType {
+	return &RecordType{name, fields}
+}
+
+// New returns a record of this type holding values, one for each field.
+// The record takes ownership of the slice.
+func (t *RecordType) New(values []Value) *Record {
+	return &Record{t, values}
+}
+
+// Field returns the index of the named field, or -1.
+func (t *RecordType) Field(name string) int {
+	for i, f := range t.Fields {
+		if f == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// Record is an immutable value of a user defined RecordType. Its Type is
+// the name of the record type. Records are atoms and evaluate to
+// themselves.
+type Record struct {
+	rtype  *RecordType
+	values []Value
+}
+
+// RecordType returns the type of the record.
+func (r *Record) RecordType() *RecordType {
+	return r.rtype
+}
+
+// Get returns the value of the field at index i.
+func (r *Record) Get(i int) Value {
+	return r.values[i]
+}
+
+// With returns a copy of the record with the field at index i set to v.
+func (r *Record) With(i int, v Value) *Record {
+	values := make([]Value, len(r.values))
+	copy(values, r.values)
+	values[i] = v
+	return &Record{r.rtype, values}
+}
+
+func (r *Record) First() Value {
+	return Nil
+}
+
+func (r *Record) Rest() Value {
+	return Nil
+}
+
+func (r *Record) Type() Type {
+	return Type(r.rtype.Name)
+}
+
+// String prints the record type name followed by its fields and their
+// values, such as #point{x 1 y 2}.
+func (r *Record) String() string {
+	var b strings.Builder
+	b.WriteString("#")
+	b.WriteString(r.rtype.Name)
+	b.WriteString("{")
+	for i, f := range r.rtype.Fields {
+		if i > 0 {
+			b.WriteString(" ")
+		}
+		b.WriteString(f)
+		b.WriteString(" ")
+		b.WriteString(Print(r.values[i]))
+	}
+	b.WriteString("}")
+	return b.String()
+}
+
+// Equal reports whether v is a record of the same RecordType with equal
+// fields.
+func (r *Record) Equal(v Value) bool {
+	o, ok := v.(*Record)
+	if !ok || o.rtype != r.rtype {
+		return false
+	}
+	for i := range r.values {
+		if !Equal(r.values[i], o.values[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *Record) Hash() (uint64, bool) {
+	return hashAll(hashString(r.Type(), ""), r.values...)
+}
@@ -0,0 +1,39 @@
+package object
+
+import "testing"
+
+func TestRecord(t *testing.T) {
+	point := NewRecordType("point", []string{"x", "y"})
+	p := point.New([]Value{Number(1), Number(2)})
+	if p.Type() != Type("point") {
+		t.Errorf("want type point. got %v", p.Type())
+	}
+	if !IsSelfEvaluating(p) {
+		t.Errorf("want records to evaluate to themselves")
+	}
+	if got := p.String(); got != "#point{x 1 y 2}" {
+		t.Errorf("want #point{x 1 y 2}. got %v", got)
+	}
+	q := p.With(point.Field("y"), Number(3))
+	if p.Get(1) != Number(2) || q.Get(1) != Number(3) {
+		t.Errorf("want with to copy. got %v and %v", p, q)
+	}
+	if point.Field("z") != -1 {
+		t.Errorf("want no field z")
+	}
+	if !Equal(p, point.New([]Value{Number(1), Number(2)})) {
+		t.Errorf("want records with equal fields to be equal")
+	}
+	if Equal(p, q) {
+		t.Errorf("want records with different fields to differ")
+	}
+	other := NewRecordType("point", []string{"x", "y"})
+	if Equal(p, other.New([]Value{Number(1), Number(2)})) {
+		t.Errorf("want records of different types to differ")
+	}
+	h1, ok1 := Hash(p)
+	h2, ok2 := Hash(point.New([]Value{Number(1), Number(2)}))
+	if !ok1 || !ok2 || h1 != h2 {
+		t.Errorf("want equal records to hash equally")
+	}
+}
@@ -0,0 +1,97 @@
+package object
+
+import (
+	"fmt"
+	"sync"
+)
+
+// TypeInfo holds the hooks for a Value type registered by a Go embedder,
+// for example a handle to a database row.
+type TypeInfo struct {
+	// SelfEvaluating values evaluate to themselves. Evaluating any other
+	// registered value is an error.
+	SelfEvaluating bool
+
+	// Equal reports whether two values of the type are the same. If it is
+	// nil the Equal method of the value is used if it has one, otherwise
+	// values are compared by identity.
+	Equal func(a, b Value) bool
+
+	// Hash returns a hash which is the same for values which are Equal. If
+	// it is nil the Hash method of the value is used if it has one,
+	// otherwise values of the type cannot be used as map keys or set
+	// elements.
+	Hash func(v Value) uint64
+
+	// Print returns the printed form of a value. If it is nil the value's
+	// String method is used.
+	Print func(v Value) string
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[Type]TypeInfo{}
+)
+
+// builtinTypes may not be registered.
+var builtinTypes = map[Type]bool{
+	SYMBOL: true, STRING: true, CHAR: true, BYTES: true, NUMBER: true,
+	BIGINT: true, RATIO: true, FLOAT: true, CELL: true, VECTOR: true,
+	MAP: true, SET: true, NIL: true, QUOTED: true, UNQUOTED: true,
+	FUNCTION: true, ERROR: true, PROMISE: true, FUTURE: true, CHANNEL: true,
+	ACTOR: true,
+}
+
+// RegisterType registers the hooks for values whose Type method returns t.
+// Builtin types cannot be registered and each type can be registered once.
+func RegisterType(t Type, info TypeInfo) error {
+	if builtinTypes[t] {
+		return fmt.Errorf("cannot register builtin type %v", t)
+	}
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if _, ok := registry[t]; ok {
+		return fmt.Errorf("type %v is already registered", t)
+	}
+	registry[t] = info
+	return nil
+}
+
+// LookupType returns the hooks registered for t.
+func LookupType(t Type) (TypeInfo, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	info, ok := registry[t]
+	return info, ok
+}
+
+// IsBuiltinType reports whether t is the type of a builtin value.
+func IsBuiltinType(t Type) bool {
+	return builtinTypes[t]
+}
+
+// IsSelfEvaluating reports whether v is a record or a value of a type
+// registered as self-evaluating. Builtin types are evaluated by eval.
+func IsSelfEvaluating(v Value) bool {
+	if _, ok := v.(*Record); ok {
+		return true
+	}
+	info, ok := LookupType(v.Type())
+	return ok && info.SelfEvaluating
+}
+
+func registered(t Type) (TypeInfo, bool) {
+	if builtinTypes[t] {
+		return TypeInfo{}, false
+	}
+	return LookupType(t)
+}
+
+// Print returns the printed form of v, using the Print hook of a registered
+// type. Containers print their elements with Print.
+func Print(v Value) string {
+	if info, ok := registered(v.Type()); ok && info.Print != nil {
+		return info.Print(v)
+	}
+	return v.String()
+}
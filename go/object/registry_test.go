@@ -0,0 +1,61 @@
+package object
+
+import (
+	"fmt"
+	"testing"
+)
+
+// row stands in for an embedder's handle to a database row.
+type row struct {
+	id   int
+	name string
+}
+
+const ROW = "test-row"
+
+func (r *row) First() Value   { return Nil }
+func (r *row) Rest() Value    { return Nil }
+func (r *row) Type() Type     { return ROW }
+func (r *row) String() string { return "<row>" }
+
+func init() {
+	err := RegisterType(ROW, TypeInfo{
+		SelfEvaluating: true,
+		Equal:          func(a, b Value) bool { return a.(*row).id == b.(*row).id },
+		Hash:           func(v Value) uint64 { return uint64(v.(*row).id) },
+		Print:          func(v Value) string { return fmt.Sprintf("#<row %v %v>", v.(*row).id, v.(*row).name) },
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+func TestRegisterType(t *testing.T) {
+	if err := RegisterType(ROW, TypeInfo{}); err == nil {
+		t.Errorf("want an error registering a type twice")
+	}
+	if err := RegisterType(CELL, TypeInfo{}); err == nil {
+		t.Errorf("want an error registering a builtin type")
+	}
+	if _, ok := LookupType("test-unregistered"); ok {
+		t.Errorf("want no hooks for an unregistered type")
+	}
+
+	a, b, c := &row{1, "a"}, &row{1, "a again"}, &row{2, "c"}
+	if !IsSelfEvaluating(a) || IsSelfEvaluating(Symbol("a")) {
+		t.Errorf("want only the registered type to self evaluate")
+	}
+	if !Equal(a, b) || Equal(a, c) {
+		t.Errorf("want equality by id")
+	}
+	m, ok := EmptyMap.Assoc(a, Symbol("found"))
+	if !ok {
+		t.Fatalf("want the registered type to be hashable")
+	}
+	if v, _ := m.Get(b); v != Symbol("found") {
+		t.Errorf("want lookup by an equal row. got %v", v)
+	}
+	if got := Print(Cell(a, Cell(NewVector([]Value{c}), Nil))); got != "(#<row 1 a> [#<row 2 c>])" {
+		t.Errorf("want rows printed by the hook. got %v", got)
+	}
+}
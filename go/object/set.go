@@ -0,0 +1,179 @@
+package object
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Set is a persistent set of hashable values, stored as the keys of a Map.
+// It prints its elements in the order of Compare, so that equal sets print
+// the same. As a list its First is the first element in that order
+// and its Rest is the set of the remaining elements.
+type Set struct {
+	m *Map
+
+	// sorted holds the elements in order once they have been sorted. The
+	// Rest of a sorted set shares it, so walking a set as a list sorts it
+	// once.
+	once   sync.Once
+	sorted []Value
+}
+
+// EmptySet is the set with no elements.
+var EmptySet = &Set{m: EmptyMap}
+
+// Len returns the number of elements.
+func (s *Set) Len() int {
+	return s.m.Len()
+}
+
+// Contains reports whether v is an element.
+func (s *Set) Contains(v Value) bool {
+	_, ok := s.m.Get(v)
+	return ok
+}
+
+// Add returns a set with v added, and false if v cannot be hashed.
+func (s *Set) Add(v Value) (*Set, bool) {
+	if s.Contains(v) {
+		return s, true
+	}
+	m, ok := s.m.Assoc(v, Nil)
+	if !ok {
+		return nil, false
+	}
+	return &Set{m: m}, true
+}
+
+// Remove returns a set without v.
+func (s *Set) Remove(v Value) *Set {
+	return &Set{m: s.m.Dissoc(v)}
+}
+
+// Range calls f for each element until it returns false.
+func (s *Set) Range(f func(v Value) bool) {
+	s.m.Range(func(k, _ Value) bool {
+		return f(k)
+	})
+}
+
+// Union returns the elements in either set.
+func (s *Set) Union(o *Set) *Set {
+	if o.Len() > s.Len() {
+		s, o = o, s
+	}
+	o.Range(func(v Value) bool {
+		s, _ = s.Add(v)
+		return true
+	})
+	return s
+}
+
+// Intersection returns the elements in both sets.
+func (s *Set) Intersection(o *Set) *Set {
+	if o.Len() < s.Len() {
+		s, o = o, s
+	}
+	result := EmptySet
+	s.Range(func(v Value) bool {
+		if o.Contains(v) {
+			result, _ = result.Add(v)
+		}
+		return true
+	})
+	return result
+}
+
+// Difference returns the elements of s which are not in o.
+func (s *Set) Difference(o *Set) *Set {
+	o.Range(func(v Value) bool {
+		s = s.Remove(v)
+		return true
+	})
+	return s
+}
+
+// Sorted returns the elements in the order of Compare.
+func (s *Set) Sorted() []Value {
+	return append([]Value(nil), s.elems()...)
+}
+
+// elems returns the sorted elements, which must not be modified.
+func (s *Set) elems() []Value {
+	s.once.Do(func() {
+		elems := make([]Value, 0, s.Len())
+		s.Range(func(v Value) bool {
+			elems = append(elems, v)
+			return true
+		})
+		sort.SliceStable(elems, func(i, j int) bool {
+			return Compare(elems[i], elems[j]) < 0
+		})
+		s.sorted = elems
+	})
+	return s.sorted
+}
+
+func (s *Set) First() Value {
+	if s.Len() == 0 {
+		return Nil
+	}
+	return s.elems()[0]
+}
+
+func (s *Set) Rest() Value {
+	if s.Len() < 2 {
+		return Nil
+	}
+	elems := s.elems()
+	rest := s.Remove(elems[0])
+	rest.once.Do(func() {
+		rest.sorted = elems[1:]
+	})
+	return rest
+}
+
+func (s *Set) Type() Type {
+	return SET
+}
+
+func (s *Set) String() string {
+	var b strings.Builder
+	b.WriteString("#{")
+	for i, v := range s.elems() {
+		if i > 0 {
+			b.WriteString(" ")
+		}
+		b.WriteString(Print(v))
+	}
+	b.WriteString("}")
+	return b.String()
+}
+
+// Equal reports whether v is a set with the same elements, regardless of
+// the order in which they were added.
+func (s *Set) Equal(v Value) bool {
+	o, ok := v.(*Set)
+	if !ok || s.Len() != o.Len() {
+		return false
+	}
+	equal := true
+	s.Range(func(v Value) bool {
+		equal = o.Contains(v)
+		return equal
+	})
+	return equal
+}
+
+// Hash combines the elements independently of their order.
+func (s *Set) Hash() (uint64, bool) {
+	h := hashString(SET, "")
+	s.Range(func(v Value) bool {
+		// Elements are always hashable.
+		vh, _ := Hash(v)
+		h += vh
+		return true
+	})
+	return h, true
+}
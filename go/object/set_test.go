@@ -0,0 +1,76 @@
+package object
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSet(t *testing.T) {
+	s := EmptySet
+	if s.First() != Nil || s.Rest() != Nil || s.String() != "#{}" {
+		t.Errorf("want an empty set. got %v", s)
+	}
+	for _, v := range []Value{Symbol("c"), Number(2), Symbol("a"), Symbol("c"), String("b")} {
+		s, _ = s.Add(v)
+	}
+	if got := s.String(); got != `#{2 a c "b"}` {
+		t.Errorf(`want #{2 a c "b"}. got %v`, got)
+	}
+	if s.Len() != 4 || !s.Contains(Symbol("a")) || s.Contains(Symbol("b")) {
+		t.Errorf("want membership of 4 elements. got %v", s)
+	}
+	if got := s.First().String(); got != "2" {
+		t.Errorf("want first 2. got %v", got)
+	}
+	if got := s.Rest().String(); got != `#{a c "b"}` {
+		t.Errorf(`want rest #{a c "b"}. got %v`, got)
+	}
+	var walked []string
+	for v := Value(s); v != Nil; v = v.Rest() {
+		if set := v.(*Set); set != s && set.sorted == nil {
+			t.Errorf("want rest %v to share the sorted elements", set)
+		}
+		walked = append(walked, v.First().String())
+	}
+	if got := fmt.Sprint(walked); got != `[2 a c "b"]` {
+		t.Errorf(`want walk [2 a c "b"]. got %v`, got)
+	}
+	if _, ok := s.Add(NewChannel(0)); ok {
+		t.Errorf("want channels to be unhashable")
+	}
+}
+
+func TestSetAlgebra(t *testing.T) {
+	set := func(vs ...Value) *Set {
+		s := EmptySet
+		for _, v := range vs {
+			s, _ = s.Add(v)
+		}
+		return s
+	}
+	a := set(Number(1), Number(2), Number(3))
+	b := set(Number(2), Number(3), Number(4))
+	tests := []struct {
+		name string
+		got  *Set
+		want string
+	}{
+		{"union", a.Union(b), "#{1 2 3 4}"},
+		{"intersection", a.Intersection(b), "#{2 3}"},
+		{"difference", a.Difference(b), "#{1}"},
+		{"difference reversed", b.Difference(a), "#{4}"},
+		{"union with empty", a.Union(EmptySet), "#{1 2 3}"},
+		{"intersection with empty", a.Intersection(EmptySet), "#{}"},
+	}
+	for _, tt := range tests {
+		if got := tt.got.String(); got != tt.want {
+			t.Errorf("%v: want %v. got %v", tt.name, tt.want, got)
+		}
+	}
+	if a.String() != "#{1 2 3}" || b.String() != "#{2 3 4}" {
+		t.Errorf("set algebra modified its arguments: %v %v", a, b)
+	}
+	if !Equal(set(Symbol("x"), Symbol("y")), set(Symbol("y"), Symbol("x"))) {
+		t.Errorf("want equality to ignore insertion order")
+	}
+}
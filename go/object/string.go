@@ -0,0 +1,43 @@
+package object
+
+import (
+	"strconv"
+	"unicode/utf8"
+)
+
+// String is text. Unlike a symbol it evaluates to itself and prints
+// quoted, so that it reads back as the same string.
+type String string
+
+func (s String) First() Value {
+	if s == "" {
+		return Nil
+	}
+	r, _ := utf8.DecodeRuneInString(string(s))
+	return Char(r)
+}
+
+func (s String) Rest() Value {
+	_, size := utf8.DecodeRuneInString(string(s))
+	if len(s) <= size {
+		return Nil
+	}
+	return s[size:]
+}
+
+func (s String) Type() Type {
+	return STRING
+}
+
+func (s String) String() string {
+	return strconv.Quote(string(s))
+}
+
+func (s String) Equal(v Value) bool {
+	o, ok := v.(String)
+	return ok && o == s
+}
+
+func (s String) Hash() (uint64, bool) {
+	return hashString(STRING, string(s)), true
+}
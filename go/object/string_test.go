@@ -0,0 +1,57 @@
+package object
+
+import "testing"
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		str    String
+		first  string
+		rest   string
+		string string
+	}{{
+		str:    "",
+		first:  "()",
+		rest:   "()",
+		string: `""`,
+	}, {
+		str:    "a",
+		first:  `#\a`,
+		rest:   "()",
+		string: `"a"`,
+	}, {
+		str:    "hello world",
+		first:  `#\h`,
+		rest:   `"ello world"`,
+		string: `"hello world"`,
+	}, {
+		str:    "héllo",
+		first:  `#\h`,
+		rest:   `"éllo"`,
+		string: `"héllo"`,
+	}, {
+		str:    "é!",
+		first:  `#\é`,
+		rest:   `"!"`,
+		string: `"é!"`,
+	}, {
+		str:    "say \"hi\"\n\ttab\\",
+		first:  `#\s`,
+		rest:   `"ay \"hi\"\n\ttab\\"`,
+		string: `"say \"hi\"\n\ttab\\"`,
+	}}
+
+	for _, tt := range tests {
+		first := tt.str.First().String()
+		if first != tt.first {
+			t.Errorf("given %q. want first %q. got %q", tt.str, tt.first, first)
+		}
+		rest := tt.str.Rest().String()
+		if rest != tt.rest {
+			t.Errorf("given %q. want rest %q. got %q", tt.str, tt.rest, rest)
+		}
+		got := tt.str.String()
+		if got != tt.string {
+			t.Errorf("given %q. want string %q. got %q", tt.str, tt.string, got)
+		}
+	}
+}
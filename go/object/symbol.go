@@ -1,19 +1,27 @@
 package object
 
+import (
+	"unicode/utf8"
+)
+
+// Symbol is a name. As a list it is its runes: First is the first rune as
+// a Char and Rest is the symbol of the remaining runes.
 type Symbol string
 
 func (s Symbol) First() Value {
 	if s == "" {
 		return Nil
 	}
-	return s[0:1]
+	r, _ := utf8.DecodeRuneInString(string(s))
+	return Char(r)
 }
 
 func (s Symbol) Rest() Value {
-	if len(s) < 2 {
+	_, size := utf8.DecodeRuneInString(string(s))
+	if len(s) <= size {
 		return Nil
 	}
-	return s[1:]
+	return s[size:]
 }
 
 func (s Symbol) Type() Type {
@@ -26,3 +34,12 @@ func (s Symbol) String() string {
 	}
 	return string(s)
 }
+
+func (s Symbol) Equal(v Value) bool {
+	o, ok := v.(Symbol)
+	return ok && o == s
+}
+
+func (s Symbol) Hash() (uint64, bool) {
+	return hashString(SYMBOL, string(s)), true
+}
@@ -15,19 +15,39 @@ func TestSymbol(t *testing.T) {
 		string: "()",
 	}, {
 		symbol: "a",
-		first:  "a",
+		first:  `#\a`,
 		rest:   "()",
 		string: "a",
 	}, {
 		symbol: "ab",
-		first:  "a",
+		first:  `#\a`,
 		rest:   "b",
 		string: "ab",
 	}, {
 		symbol: "abc",
-		first:  "a",
+		first:  `#\a`,
 		rest:   "bc",
 		string: "abc",
+	}, {
+		symbol: "é",
+		first:  `#\é`,
+		rest:   "()",
+		string: "é",
+	}, {
+		symbol: "λx",
+		first:  `#\λ`,
+		rest:   "x",
+		string: "λx",
+	}, {
+		symbol: "日本語",
+		first:  `#\日`,
+		rest:   "本語",
+		string: "日本語",
+	}, {
+		symbol: "a😀b",
+		first:  `#\a`,
+		rest:   "😀b",
+		string: "a😀b",
 	}}
 
 	for _, tt := range tests {
@@ -24,5 +24,14 @@ func (u unquoted) Type() Type {
 }
 
 func (u unquoted) String() string {
-	return "`" + u.value.String()
+	return "`" + Print(u.value)
+}
+
+func (u unquoted) Equal(v Value) bool {
+	o, ok := v.(unquoted)
+	return ok && Equal(u.value, o.value)
+}
+
+func (u unquoted) Hash() (uint64, bool) {
+	return hashAll(hashString(UNQUOTED, ""), u.value)
 }
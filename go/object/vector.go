@@ -0,0 +1,92 @@
+package object
+
+import (
+	"strings"
+)
+
+// Vector is an immutable sequence with constant time indexing. As a list
+// its First is the first element and its Rest is the vector of the
+// remaining elements, which shares storage with the original.
+type Vector struct {
+	elems []Value
+}
+
+// NewVector returns a vector of elems. The vector takes ownership of the
+// slice, which must not be modified afterwards.
+func NewVector(elems []Value) *Vector {
+	return &Vector{elems}
+}
+
+// Len returns the number of elements.
+func (v *Vector) Len() int {
+	return len(v.elems)
+}
+
+// Ref returns the element at index i, which must be in range.
+func (v *Vector) Ref(i int) Value {
+	return v.elems[i]
+}
+
+// Set returns a new vector with the element at index i, which must be in
+// range, replaced by e.
+func (v *Vector) Set(i int, e Value) *Vector {
+	elems := make([]Value, len(v.elems))
+	copy(elems, v.elems)
+	elems[i] = e
+	return &Vector{elems}
+}
+
+// Elements returns a copy of the elements.
+func (v *Vector) Elements() []Value {
+	elems := make([]Value, len(v.elems))
+	copy(elems, v.elems)
+	return elems
+}
+
+func (v *Vector) First() Value {
+	if len(v.elems) == 0 {
+		return Nil
+	}
+	return v.elems[0]
+}
+
+func (v *Vector) Rest() Value {
+	if len(v.elems) < 2 {
+		return Nil
+	}
+	return &Vector{v.elems[1:]}
+}
+
+func (v *Vector) Type() Type {
+	return VECTOR
+}
+
+func (v *Vector) String() string {
+	var b strings.Builder
+	b.WriteString("[")
+	for i, e := range v.elems {
+		if i > 0 {
+			b.WriteString(" ")
+		}
+		b.WriteString(Print(e))
+	}
+	b.WriteString("]")
+	return b.String()
+}
+
+func (v *Vector) Equal(o Value) bool {
+	w, ok := o.(*Vector)
+	if !ok || len(v.elems) != len(w.elems) {
+		return false
+	}
+	for i := range v.elems {
+		if !Equal(v.elems[i], w.elems[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (v *Vector) Hash() (uint64, bool) {
+	return hashAll(hashString(VECTOR, ""), v.elems...)
+}
@@ -0,0 +1,61 @@
+package object
+
+import "testing"
+
+func TestVector(t *testing.T) {
+	tests := []struct {
+		vector *Vector
+		first  string
+		rest   string
+		string string
+	}{{
+		vector: NewVector(nil),
+		first:  "()",
+		rest:   "()",
+		string: "[]",
+	}, {
+		vector: NewVector([]Value{Number(1)}),
+		first:  "1",
+		rest:   "()",
+		string: "[1]",
+	}, {
+		vector: NewVector([]Value{Number(1), Symbol("a"), String("b")}),
+		first:  "1",
+		rest:   `[a "b"]`,
+		string: `[1 a "b"]`,
+	}, {
+		vector: NewVector([]Value{NewVector([]Value{Number(1)}), Cell(Number(2), Nil)}),
+		first:  "[1]",
+		rest:   "[(2)]",
+		string: "[[1] (2)]",
+	}}
+
+	for _, tt := range tests {
+		first := tt.vector.First().String()
+		if first != tt.first {
+			t.Errorf("given %v. want first %v. got %v", tt.vector, tt.first, first)
+		}
+		rest := tt.vector.Rest().String()
+		if rest != tt.rest {
+			t.Errorf("given %v. want rest %v. got %v", tt.vector, tt.rest, rest)
+		}
+		got := tt.vector.String()
+		if got != tt.string {
+			t.Errorf("given %v. want string %q. got %q", tt.vector, tt.string, got)
+		}
+	}
+}
+
+func TestVectorSet(t *testing.T) {
+	v := NewVector([]Value{Number(1), Number(2), Number(3)})
+	w := v.Set(1, Symbol("b"))
+	if got := v.String(); got != "[1 2 3]" {
+		t.Errorf("set modified the original: %v", got)
+	}
+	if got := w.String(); got != "[1 b 3]" {
+		t.Errorf("want [1 b 3]. got %v", got)
+	}
+	if w.Len() != 3 || w.Ref(2) != Number(3) {
+		t.Errorf("want length 3 ending in 3. got %v", w)
+	}
+}
@@ -7,8 +7,10 @@ import (
 	"dabble/object"
 	"dabble/token"
 	"fmt"
+	"math/big"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 )
 
 type Parser struct {
@@ -48,18 +50,29 @@ func (p *Parser) parseValue() object.Value {
 	case token.UNQUOTE:
 		p.nextToken()
 		return object.Unquoted(p.parseValue())
-	case token.RPAREN:
+	case token.RPAREN, token.RBRACKET, token.RBRACE:
 		p.error("unexpected: %v", p.curToken.Literal)
 		return object.Nil
+	case token.LBRACKET:
+		p.nextToken()
+		return p.parseVector()
+	case token.LBRACE:
+		p.nextToken()
+		return p.parseMap()
+	case token.HASHBRACE:
+		p.nextToken()
+		return p.parseSet()
+	case token.BYTES:
+		p.nextToken()
+		return p.parseBytes()
 	case token.SYMBOL:
 		return object.Symbol(p.curToken.Literal)
+	case token.STRING:
+		return object.String(p.curToken.Literal)
 	case token.NUMBER:
-		i, err := strconv.ParseUint(p.curToken.Literal, 10, 64)
-		if err != nil {
-			p.error("invalid number: %v", err.Error())
-			return object.Nil
-		}
-		return object.Number(i)
+		return p.parseNumber()
+	case token.CHAR:
+		return p.parseChar()
 	case token.EOF:
 		p.error("end of file")
 		return object.Nil
@@ -75,6 +88,56 @@ func (p *Parser) parseValue() object.Value {
 	}
 }
 
+func (p *Parser) parseNumber() object.Value {
+	literal := p.curToken.Literal
+	switch {
+	case strings.Contains(literal, "/"):
+		parts := strings.SplitN(literal, "/", 2)
+		num, ok := new(big.Int).SetString(parts[0], 10)
+		den, ok2 := new(big.Int).SetString(parts[1], 10)
+		if !ok || !ok2 || den.Sign() == 0 {
+			p.error("invalid ratio: %v", literal)
+			return object.Nil
+		}
+		return object.Rational(new(big.Rat).SetFrac(num, den))
+	case strings.ContainsAny(literal, ".eE"):
+		f, err := strconv.ParseFloat(literal, 64)
+		if err != nil {
+			p.error("invalid float: %v", literal)
+			return object.Nil
+		}
+		return object.Float(f)
+	default:
+		i, ok := new(big.Int).SetString(literal, 10)
+		if !ok {
+			p.error("invalid number: %v", literal)
+			return object.Nil
+		}
+		return object.Integer(i)
+	}
+}
+
+// parseChar parses the text of a character literal: a single character, a
+// name such as space, or u followed by a hexadecimal code point.
+func (p *Parser) parseChar() object.Value {
+	literal := p.curToken.Literal
+	if utf8.RuneCountInString(literal) == 1 {
+		r, _ := utf8.DecodeRuneInString(literal)
+		return object.Char(r)
+	}
+	if c, ok := object.CharNames[literal]; ok {
+		return c
+	}
+	if strings.HasPrefix(literal, "u") {
+		n, err := strconv.ParseUint(literal[1:], 16, 32)
+		if err == nil && utf8.ValidRune(rune(n)) {
+			return object.Char(n)
+		}
+	}
+	p.error("invalid character: #\\%v", literal)
+	return object.Nil
+}
+
 func (p *Parser) parseCell() object.Value {
 	switch p.curToken.Type {
 	case token.RPAREN:
@@ -106,6 +169,107 @@ func (p *Parser) parseCell() object.Value {
 	}
 }
 
+// parseVector parses the elements of a vector up to the closing bracket.
+func (p *Parser) parseVector() object.Value {
+	var elems []object.Value
+	for p.curToken.Type != token.RBRACKET {
+		switch p.curToken.Type {
+		case token.EOF:
+			p.error("end of file")
+			return object.Nil
+		case token.ILLEGAL:
+			p.error("illegal: %v", p.curToken.Literal)
+			return object.Nil
+		case token.DOT, token.RPAREN, token.RBRACE:
+			p.error("unexpected in vector: %v", p.curToken.Literal)
+			return object.Nil
+		}
+		elems = append(elems, p.parseValue())
+		p.nextToken()
+	}
+	return object.NewVector(elems)
+}
+
+// parseMap parses the keys and values of a map up to the closing brace.
+func (p *Parser) parseMap() object.Value {
+	var elems []object.Value
+	for p.curToken.Type != token.RBRACE {
+		switch p.curToken.Type {
+		case token.EOF:
+			p.error("end of file")
+			return object.Nil
+		case token.ILLEGAL:
+			p.error("illegal: %v", p.curToken.Literal)
+			return object.Nil
+		case token.DOT, token.RPAREN, token.RBRACKET:
+			p.error("unexpected in map: %v", p.curToken.Literal)
+			return object.Nil
+		}
+		elems = append(elems, p.parseValue())
+		p.nextToken()
+	}
+	if len(elems)%2 != 0 {
+		p.error("map with odd number of forms: %v", len(elems))
+		return object.Nil
+	}
+	m := object.EmptyMap
+	for i := 0; i < len(elems); i += 2 {
+		var ok bool
+		m, ok = m.Assoc(elems[i], elems[i+1])
+		if !ok {
+			p.error("unhashable map key: %v", elems[i])
+			return object.Nil
+		}
+	}
+	return m
+}
+
+// parseSet parses the elements of a set up to the closing brace.
+func (p *Parser) parseSet() object.Value {
+	s := object.EmptySet
+	for p.curToken.Type != token.RBRACE {
+		switch p.curToken.Type {
+		case token.EOF:
+			p.error("end of file")
+			return object.Nil
+		case token.ILLEGAL:
+			p.error("illegal: %v", p.curToken.Literal)
+			return object.Nil
+		case token.DOT, token.RPAREN, token.RBRACKET:
+			p.error("unexpected in set: %v", p.curToken.Literal)
+			return object.Nil
+		}
+		v := p.parseValue()
+		var ok bool
+		s, ok = s.Add(v)
+		if !ok {
+			p.error("unhashable set element: %v", v)
+			return object.Nil
+		}
+		p.nextToken()
+	}
+	return s
+}
+
+// parseBytes parses the numbers of a byte array up to the closing paren.
+func (p *Parser) parseBytes() object.Value {
+	var b []byte
+	for p.curToken.Type != token.RPAREN {
+		if p.curToken.Type == token.EOF {
+			p.error("end of file")
+			return object.Nil
+		}
+		n, err := strconv.ParseUint(p.curToken.Literal, 10, 8)
+		if p.curToken.Type != token.NUMBER || err != nil {
+			p.error("invalid byte: %v", p.curToken.Literal)
+			return object.Nil
+		}
+		b = append(b, byte(n))
+		p.nextToken()
+	}
+	return object.Bytes(b)
+}
+
 func (p *Parser) parseDottedList(first object.Value) object.Value {
 	rest := p.parseValue()
 	if rest.Type() == object.ERROR {
@@ -137,8 +301,15 @@ func (p *Parser) parseList() object.Value {
 	}
 }
 
+// nextToken advances to the next token, skipping the value following each
+// #; datum comment.
 func (p *Parser) nextToken() {
 	p.curToken = p.l.NextToken()
+	for p.curToken.Type == token.DATUM {
+		p.nextToken()
+		p.parseValue()
+		p.curToken = p.l.NextToken()
+	}
 }
 
 func (p *Parser) error(s string, args ...interface{}) {
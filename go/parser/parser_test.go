@@ -18,6 +18,18 @@ func TestParser(t *testing.T) {
 	}, {
 		input:  "1234",
 		object: object.Number(1234),
+	}, {
+		input:  "-1234",
+		object: object.Number(-1234),
+	}, {
+		input:  "+5",
+		object: object.Number(5),
+	}, {
+		input:  "-9223372036854775808",
+		object: object.Number(-9223372036854775808),
+	}, {
+		input:  "9223372036854775807",
+		object: object.Number(9223372036854775807),
 	}, {
 		input:  "()",
 		object: object.Nil,
@@ -44,8 +56,27 @@ func TestParser(t *testing.T) {
 				object.Cell(object.Symbol("baz"), nil))),
 	}, {
 		input: `("""")`,
-		object: object.Cell(object.Symbol(""),
-			object.Cell(object.Symbol(""), nil)),
+		object: object.Cell(object.String(""),
+			object.Cell(object.String(""), nil)),
+	}, {
+		input:  `"foo bar"`,
+		object: object.String("foo bar"),
+	}, {
+		input:  `"say \"hi\"\n"`,
+		object: object.String("say \"hi\"\n"),
+	}, {
+		input:  "\"two\nlines\"",
+		object: object.String("two\nlines"),
+	}, {
+		input: `(error "oops")`,
+		object: object.Cell(object.Symbol("error"),
+			object.Cell(object.String("oops"), nil)),
+	}, {
+		input:   `"unterminated`,
+		wantErr: true,
+	}, {
+		input:   `"bad \q escape"`,
+		wantErr: true,
 	}, {
 		input:  "(1 . 2)",
 		object: object.Cell(object.Number(1), object.Number(2)),
@@ -105,10 +136,290 @@ func TestParser(t *testing.T) {
 				if err != nil {
 					t.Errorf("unwanted: %v", err)
 				}
-				if v != tt.object {
+				if !object.Equal(v, tt.object) {
 					t.Errorf("want %v. got %v", tt.object, v)
 				}
 			}
 		})
 	}
 }
+
+func TestParseNumber(t *testing.T) {
+	tests := []struct {
+		input   string
+		typ     object.Type
+		want    string
+		wantErr bool
+	}{
+		{input: "1/2", typ: object.RATIO, want: "1/2"},
+		{input: "-3/6", typ: object.RATIO, want: "-1/2"},
+		{input: "+010/4", typ: object.RATIO, want: "5/2"},
+		{input: "4/2", typ: object.NUMBER, want: "2"},
+		{input: "100000000000000000000/3", typ: object.RATIO, want: "100000000000000000000/3"},
+		{input: "1/0", wantErr: true},
+		{input: "1.5", typ: object.FLOAT, want: "1.5"},
+		{input: "-0.25", typ: object.FLOAT, want: "-0.25"},
+		{input: "2.0", typ: object.FLOAT, want: "2.0"},
+		{input: "1e3", typ: object.FLOAT, want: "1000.0"},
+		{input: "1E21", typ: object.FLOAT, want: "1e+21"},
+		{input: "2.5e-7", typ: object.FLOAT, want: "2.5e-07"},
+		{input: "0.1", typ: object.FLOAT, want: "0.1"},
+		{input: "1e400", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			v, err := New(lexer.New(tt.input)).ParseProgram()
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("wanted error. got %v", v)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unwanted: %v", err)
+			}
+			if v.Type() != tt.typ || v.String() != tt.want {
+				t.Errorf("want %v %v. got %v %v", tt.typ, tt.want, v.Type(), v)
+			}
+			// Printed numbers read back as themselves.
+			again, err := New(lexer.New(v.String())).ParseProgram()
+			if err != nil {
+				t.Fatalf("unwanted: %v", err)
+			}
+			if again.Type() != v.Type() || again.String() != v.String() {
+				t.Errorf("round trip of %v gave %v %v", v, again.Type(), again)
+			}
+		})
+	}
+}
+
+func TestParseChar(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    object.Value
+		wantErr bool
+	}{
+		{input: `#\a`, want: object.Char('a')},
+		{input: `#\λ`, want: object.Char('λ')},
+		{input: `#\)`, want: object.Char(')')},
+		{input: `#\space`, want: object.Char(' ')},
+		{input: `#\newline`, want: object.Char('\n')},
+		{input: `#\u`, want: object.Char('u')},
+		{input: `#\u00e9`, want: object.Char('é')},
+		{input: `#\u1F600`, want: object.Char('😀')},
+		{input: `#\spaces`, wantErr: true},
+		{input: `#\ud800`, wantErr: true},
+		{input: `#\`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			v, err := New(lexer.New(tt.input)).ParseProgram()
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("wanted error. got %v", v)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unwanted: %v", err)
+			}
+			if v != tt.want {
+				t.Errorf("want %v. got %v", tt.want, v)
+			}
+			again, err := New(lexer.New(v.String())).ParseProgram()
+			if err != nil || again != v {
+				t.Errorf("round trip of %v gave %v %v", v, again, err)
+			}
+		})
+	}
+}
+
+func TestParseVector(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{input: "[]", want: "[]"},
+		{input: "[1 2 3]", want: "[1 2 3]"},
+		{input: "[a (b c) [d]]", want: "[a (b c) [d]]"},
+		{input: "([1] [2])", want: "([1] [2])"},
+		{input: "'[a]", want: "'[a]"},
+		{input: "[1 2", wantErr: true},
+		{input: "[1 2)", wantErr: true},
+		{input: "[1 . 2]", wantErr: true},
+		{input: "(1 2]", wantErr: true},
+		{input: "]", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			v, err := New(lexer.New(tt.input)).ParseProgram()
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("wanted error. got %v", v)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unwanted: %v", err)
+			}
+			if got := v.String(); got != tt.want {
+				t.Errorf("want %v. got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestParseMap(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{input: "{}", want: "{}"},
+		{input: "{a 1}", want: "{a 1}"},
+		{input: "{a 1 a 2}", want: "{a 2}"},
+		{input: "{[1 2] {b (c)}}", want: "{[1 2] {b (c)}}"},
+		{input: "{a}", wantErr: true},
+		{input: "{a 1", wantErr: true},
+		{input: "{a 1]", wantErr: true},
+		{input: "{'a 1}", want: "{'a 1}"},
+		{input: "[1}", wantErr: true},
+		{input: "}", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			v, err := New(lexer.New(tt.input)).ParseProgram()
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("wanted error. got %v", v)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unwanted: %v", err)
+			}
+			if got := v.String(); got != tt.want {
+				t.Errorf("want %v. got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestParseSet(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{input: "#{}", want: "#{}"},
+		{input: "#{c a b a}", want: "#{a b c}"},
+		{input: "#{[1] #{2} {3 4}}", want: "#{[1] {3 4} #{2}}"},
+		{input: "#{a", wantErr: true},
+		{input: "#{a]", wantErr: true},
+		{input: "#{'a}", want: "#{'a}"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			v, err := New(lexer.New(tt.input)).ParseProgram()
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("wanted error. got %v", v)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unwanted: %v", err)
+			}
+			if got := v.String(); got != tt.want {
+				t.Errorf("want %v. got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestParseBytes(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    object.Value
+		wantErr bool
+	}{
+		{input: "#u8()", want: object.Bytes("")},
+		{input: "#u8(104 105)", want: object.Bytes("hi")},
+		{input: "#u8(0 255)", want: object.Bytes("\x00\xff")},
+		{input: "#u8(256)", wantErr: true},
+		{input: "#u8(-1)", wantErr: true},
+		{input: "#u8(a)", wantErr: true},
+		{input: "#u8(1 2", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			v, err := New(lexer.New(tt.input)).ParseProgram()
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("wanted error. got %v", v)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unwanted: %v", err)
+			}
+			if v != tt.want {
+				t.Errorf("want %v. got %v", tt.want, v)
+			}
+			if v.String() != tt.input {
+				t.Errorf("want %v to print as %v", v, tt.input)
+			}
+		})
+	}
+}
+
+func TestParseComments(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{input: "; comment\n(a b) ; comment", want: "(a b)"},
+		{input: "(a ; comment\n b)", want: "(a b)"},
+		{input: "(a #| comment |# b)", want: "(a b)"},
+		{input: "#| outer #| inner |# outer |# a", want: "a"},
+		{input: "(a #;(b c) d)", want: "(a d)"},
+		{input: "(a #;b)", want: "(a)"},
+		{input: "(a #; #;b c d)", want: "(a d)"},
+		{input: "[a #;b c]", want: "[a c]"},
+		{input: "{a #;b 1}", want: "{a 1}"},
+		{input: "'#;a b", want: "'b"},
+		{input: "(a . #;b c)", want: "(a c)"},
+		{input: "#;a b", want: "b"},
+		{input: "a #;b", want: "a"},
+		{input: "#;a", wantErr: true},
+		{input: "(a #;)", wantErr: true},
+		{input: "(a #| b)", wantErr: true},
+		{input: "(a |# b)", want: "(a |# b)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			v, err := New(lexer.New(tt.input)).ParseProgram()
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("wanted error. got %v", v)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unwanted: %v", err)
+			}
+			if got := v.String(); got != tt.want {
+				t.Errorf("want %v. got %v", tt.want, got)
+			}
+		})
+	}
+}
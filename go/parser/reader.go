@@ -0,0 +1,170 @@
+package parser
+
+import (
+	"bufio"
+	"dabble/lexer"
+	"dabble/object"
+	"dabble/token"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Position is the place in a stream where a form starts. Line and Column
+// count from 1 and Column counts characters.
+type Position struct {
+	Offset int
+	Line   int
+	Column int
+}
+
+func (p Position) String() string {
+	return fmt.Sprintf("%v:%v", p.Line, p.Column)
+}
+
+// Form is a value read from a stream and where it started.
+type Form struct {
+	Value object.Value
+	Pos   Position
+}
+
+// Reader reads forms one at a time from a stream of any number of forms.
+// It reads a line at a time, so a form may span lines and a line may hold
+// several forms.
+type Reader struct {
+	r   *bufio.Reader
+	eof bool
+
+	// buf is input which hasn't been read as forms yet and pos is where it
+	// starts.
+	buf []byte
+	pos Position
+
+	// scanned is how much of the buffer has been scanned for brackets and
+	// depth is how many of them are open, so that the buffer is only parsed
+	// once it may hold a whole form.
+	scanned int
+	depth   int
+}
+
+func NewReader(r io.Reader) *Reader {
+	return &Reader{
+		r:   bufio.NewReader(r),
+		pos: Position{Line: 1, Column: 1},
+	}
+}
+
+// Read returns the next form. It returns io.EOF when the stream holds no
+// more forms. After a parse error the rest of the buffered input is
+// discarded, so reading continues with the next line.
+func (r *Reader) Read() (Form, error) {
+	for {
+		if !r.eof && !r.balanced() {
+			if err := r.fill(); err != nil {
+				return Form{}, err
+			}
+			continue
+		}
+		l := lexer.New(string(r.buf))
+		p := New(l)
+		if p.curToken.Type == token.EOF && len(p.errors) == 0 {
+			if r.eof {
+				r.advance(len(r.buf))
+				return Form{}, io.EOF
+			}
+			if err := r.fill(); err != nil {
+				return Form{}, err
+			}
+			continue
+		}
+		start := l.Start()
+		v := p.parseValue()
+		if len(p.errors) != 0 {
+			// An error at the end of the input may be an incomplete form.
+			if l.Offset() >= len(r.buf) && !r.eof {
+				if err := r.fill(); err != nil {
+					return Form{}, err
+				}
+				continue
+			}
+			pos := r.position(start)
+			r.advance(len(r.buf))
+			return Form{}, fmt.Errorf("%v: error parsing:\n%v", pos, strings.Join(p.errors, "\n"))
+		}
+		form := Form{Value: v, Pos: r.position(start)}
+		r.advance(l.Offset())
+		return form, nil
+	}
+}
+
+// Pending reports whether the buffered input holds more than white space
+// and comments, so that Read may return without reading the stream.
+func (r *Reader) Pending() bool {
+	return lexer.New(string(r.buf)).NextToken().Type != token.EOF
+}
+
+// balanced scans the input added since the last scan and reports whether
+// the brackets opened in the buffer have been closed after some token.
+// Scanning stops before a token which reaches the end of the buffer since
+// more input may continue it.
+func (r *Reader) balanced() bool {
+	base := r.scanned
+	text := string(r.buf[base:])
+	l := lexer.New(text)
+	for {
+		tok := l.NextToken()
+		if tok.Type == token.EOF || l.Offset() >= len(text) {
+			return false
+		}
+		r.scanned = base + l.Offset()
+		switch tok.Type {
+		case token.LPAREN, token.LBRACKET, token.LBRACE, token.HASHBRACE, token.BYTES:
+			r.depth++
+		case token.RPAREN, token.RBRACKET, token.RBRACE:
+			r.depth--
+		case token.QUOTE, token.UNQUOTE, token.DATUM:
+			continue
+		}
+		if r.depth <= 0 {
+			return true
+		}
+	}
+}
+
+// fill reads another line into the buffer.
+func (r *Reader) fill() error {
+	line, err := r.r.ReadString('\n')
+	r.buf = append(r.buf, line...)
+	if err != nil {
+		r.eof = true
+		if err != io.EOF {
+			return err
+		}
+	}
+	return nil
+}
+
+// position returns the position of a byte offset into the buffer.
+func (r *Reader) position(offset int) Position {
+	pos := r.pos
+	for _, ch := range string(r.buf[:offset]) {
+		if ch == '\n' {
+			pos.Line++
+			pos.Column = 1
+		} else {
+			pos.Column++
+		}
+	}
+	pos.Offset += offset
+	return pos
+}
+
+// advance discards n bytes of the buffer.
+func (r *Reader) advance(n int) {
+	if n > len(r.buf) {
+		n = len(r.buf)
+	}
+	r.pos = r.position(n)
+	r.buf = r.buf[n:]
+	r.scanned, r.depth = 0, 0
+}
@@ -0,0 +1,97 @@
+package parser
+
+import (
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestReader(t *testing.T) {
+	r := NewReader(strings.NewReader(`; comment
+(a) (b
+  c) 'd
+#| block |# "é" [1
+2]
+#;skipped e`))
+	want := []struct {
+		value string
+		pos   Position
+	}{
+		{"(a)", Position{Offset: 10, Line: 2, Column: 1}},
+		{"(b c)", Position{Offset: 14, Line: 2, Column: 5}},
+		{"'d", Position{Offset: 22, Line: 3, Column: 6}},
+		{`"é"`, Position{Offset: 37, Line: 4, Column: 13}},
+		{"[1 2]", Position{Offset: 42, Line: 4, Column: 17}},
+		{"e", Position{Offset: 58, Line: 6, Column: 11}},
+	}
+	for i, w := range want {
+		form, err := r.Read()
+		if err != nil {
+			t.Fatalf("forms[%d] - unwanted: %v", i, err)
+		}
+		if got := form.Value.String(); got != w.value || form.Pos != w.pos {
+			t.Errorf("forms[%d] - want %v at %+v. got %v at %+v", i, w.value, w.pos, got, form.Pos)
+		}
+	}
+	if form, err := r.Read(); err != io.EOF {
+		t.Errorf("want EOF. got %v %v", form.Value, err)
+	}
+	if _, err := r.Read(); err != io.EOF {
+		t.Errorf("want EOF again. got %v", err)
+	}
+}
+
+func TestReaderLongForm(t *testing.T) {
+	// Each line is scanned once, so a form of many lines reads quickly.
+	const lines = 100000
+	r := NewReader(strings.NewReader("(" + strings.Repeat("a\n", lines) + `"x
+y")`))
+	form, err := r.Read()
+	if err != nil {
+		t.Fatalf("unwanted: %v", err)
+	}
+	n := 0
+	for v := form.Value; v.Type() == "CELL"; v = v.Rest() {
+		n++
+	}
+	if n != lines+1 {
+		t.Errorf("want %v elements. got %v", lines+1, n)
+	}
+}
+
+func TestReaderErrors(t *testing.T) {
+	r := NewReader(strings.NewReader("(a ) b) c\n(d\n"))
+	if form, err := r.Read(); err != nil || form.Value.String() != "(a)" {
+		t.Fatalf("want (a). got %v %v", form.Value, err)
+	}
+	if _, err := r.Read(); err != nil {
+		t.Fatalf("want b. got %v", err)
+	}
+	_, err := r.Read()
+	if err == nil || !strings.HasPrefix(err.Error(), "1:7: ") {
+		t.Errorf("want an error at 1:7. got %v", err)
+	}
+	// The rest of the line is discarded and (d is incomplete.
+	_, err = r.Read()
+	if err == nil || !strings.HasPrefix(err.Error(), "2:1: ") {
+		t.Errorf("want an error at 2:1. got %v", err)
+	}
+	if _, err := r.Read(); err != io.EOF {
+		t.Errorf("want EOF. got %v", err)
+	}
+}
+
+func TestReaderPending(t *testing.T) {
+	r := NewReader(strings.NewReader("(a) (b) ; comment\n"))
+	if r.Pending() {
+		t.Errorf("want nothing pending before reading")
+	}
+	r.Read()
+	if !r.Pending() {
+		t.Errorf("want (b) pending")
+	}
+	r.Read()
+	if r.Pending() {
+		t.Errorf("want only a comment left")
+	}
+}
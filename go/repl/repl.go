@@ -1,10 +1,9 @@
 package main
 
 import (
-	"bufio"
 	"dabble/core"
 	"dabble/eval"
-	"dabble/lexer"
+	"dabble/object"
 	"dabble/parser"
 	"fmt"
 	"io"
@@ -28,33 +27,29 @@ func main() {
 const PROMPT = ">> "
 
 func Start(in io.Reader, out io.Writer) {
-	scanner := bufio.NewScanner(in)
+	reader := parser.NewReader(in)
 
 	for {
-		fmt.Fprintf(out, PROMPT)
-		scanned := scanner.Scan()
-		if !scanned {
+		if !reader.Pending() {
+			fmt.Fprintf(out, PROMPT)
+		}
+		form, err := reader.Read()
+		if err == io.EOF {
 			return
 		}
-
-		line := scanner.Text()
-		l := lexer.New(line)
-		p := parser.New(l)
-
-		program, err := p.ParseProgram()
 		if err != nil {
 			io.WriteString(out, err.Error())
 			io.WriteString(out, "\n")
 			continue
 		}
 
-		eval.BeginTrace()
-		evaluated := eval.Eval(core.Env, program)
-		trace := eval.EndTrace()
+		env := core.Env.Traced()
+		evaluated := eval.Eval(env, form.Value)
+		trace := env.Trace()
 		if evaluated != nil {
 			io.WriteString(out, trace)
 			io.WriteString(out, "\n")
-			io.WriteString(out, evaluated.String())
+			io.WriteString(out, object.Print(evaluated))
 			io.WriteString(out, "\n")
 		}
 	}
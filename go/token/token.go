@@ -9,13 +9,22 @@ const (
 	EOF     = "EOF"
 
 	SYMBOL = "SYMBOL"
+	STRING = "STRING"
 	NUMBER = "NUMBER"
+	CHAR   = "CHAR"
 
-	LPAREN  = "("
-	RPAREN  = ")"
-	DOT     = "."
-	QUOTE   = "'"
-	UNQUOTE = "`"
+	LPAREN    = "("
+	RPAREN    = ")"
+	LBRACKET  = "["
+	RBRACKET  = "]"
+	LBRACE    = "{"
+	RBRACE    = "}"
+	HASHBRACE = "#{"
+	BYTES     = "#u8("
+	DATUM     = "#;"
+	DOT       = "."
+	QUOTE     = "'"
+	UNQUOTE   = "`"
 )
 
 type Token struct {
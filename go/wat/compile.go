@@ -0,0 +1,232 @@
+package wat
+
+import (
+	"dabble/object"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Compile translates a Dabble program into a WebAssembly text module.
+//
+// The module does not contain an evaluator of its own. It imports the
+// runtime exported by wat/core.wat under the module name "core", builds the
+// program as a value using the tagged i64 layout described in wat/DESIGN.md
+// and exports a "main" function which evaluates it in an environment holding
+// the runtime builtins.
+func Compile(program object.Value) (string, error) {
+	c := &compiler{}
+	c.line("(module")
+	c.in()
+	c.line(";; Runtime provided by wat/core.wat")
+	for _, i := range imports {
+		c.line("(import %q %q (func $%v%v))", RuntimeModule, i.name, i.name, i.signature)
+	}
+	c.line("")
+	c.line(";; Initial environment with the runtime builtins")
+	c.line("(func $env (result i64)")
+	c.in()
+	c.line("(local $env i64)")
+	c.line("(local.set $env (call $nil))")
+	for _, b := range Builtins {
+		c.line("(local.set $env")
+		c.in()
+		c.line("(call $extend")
+		c.in()
+		c.symbol(object.Symbol(b.Name))
+		c.line("(call $make_builtin (i32.const %v))", b.ID)
+		c.line("(local.get $env)))")
+		c.out()
+		c.out()
+	}
+	c.line("(local.get $env))")
+	c.out()
+	c.line("")
+	c.line(";; Program: %v", strings.ReplaceAll(program.String(), "\n", " "))
+	c.line("(func $main (export \"main\") (result i64)")
+	c.in()
+	c.line("(call $eval")
+	c.in()
+	if err := c.value(program); err != nil {
+		return "", err
+	}
+	c.line("(call $env)))")
+	c.out()
+	c.out()
+	c.out()
+	c.line(")")
+	return c.b.String(), nil
+}
+
+// RuntimeModule is the import module name under which the compiled
+// program expects the exports of wat/core.wat.
+const RuntimeModule = "core"
+
+// Builtin is a function implemented by $apply_builtin in wat/core.wat.
+type Builtin struct {
+	Name string
+	ID   int32
+}
+
+// Builtins are bound in the environment of every compiled program. The IDs
+// must match the dispatch in $apply_builtin.
+var Builtins = []Builtin{
+	{"cons", 0},
+	{"car", 1},
+	{"cdr", 2},
+	{"atom", 3},
+	{"eq", 4},
+}
+
+var imports = []struct {
+	name      string
+	signature string
+}{
+	{"nil", " (result i64)"},
+	{"cons", " (param i64 i64) (result i64)"},
+	{"make_number", " (param i32) (result i64)"},
+	{"make_symbol", " (param i64) (result i64)"},
+	{"make_error", " (param i64) (result i64)"},
+	{"make_bytes1", " (param i32) (result i64)"},
+	{"make_bytes2", " (param i32) (result i64)"},
+	{"make_bytes3", " (param i32) (result i64)"},
+	{"make_bytes4", " (param i32) (result i64)"},
+	{"make_builtin", " (param i32) (result i64)"},
+	{"extend", " (param i64 i64 i64) (result i64)"},
+	{"eval", " (param i64 i64) (result i64)"},
+}
+
+type compiler struct {
+	b      strings.Builder
+	indent int
+
+	// quoted is the depth of quoted data being emitted.
+	quoted int
+}
+
+func (c *compiler) value(v object.Value) error {
+	switch v.Type() {
+	case object.NIL:
+		c.line("(call $nil)")
+	case object.NUMBER:
+		n := v.(object.Number)
+		if n < math.MinInt32 || n > math.MaxInt32 {
+			return fmt.Errorf("compile: number out of 32-bit range: %v", n)
+		}
+		c.line("(call $make_number (i32.const %v))", int64(n))
+	case object.SYMBOL:
+		c.symbol(v.(object.Symbol))
+	case object.BYTES:
+		// Bytes are a bare chain in the WAT runtime, which $eval would
+		// treat as an application, so outside quoted data they are
+		// quoted. An empty chain would be ().
+		if v.(object.Bytes) == "" {
+			return fmt.Errorf("compile: empty bytes are not supported by the WAT runtime")
+		}
+		if c.quoted == 0 {
+			return c.quote(v)
+		}
+		c.chain(string(v.(object.Bytes)))
+	case object.ERROR:
+		c.line("(call $make_error")
+		c.in()
+		c.chain(string(v.(object.Error)))
+		c.close()
+		c.out()
+	case object.CELL:
+		c.line("(call $cons")
+		c.in()
+		if err := c.value(v.First()); err != nil {
+			return err
+		}
+		if err := c.value(v.Rest()); err != nil {
+			return err
+		}
+		c.close()
+		c.out()
+	case object.QUOTED:
+		if err := checkQuoted(v.First()); err != nil {
+			return err
+		}
+		return c.quote(v.First())
+	default:
+		return fmt.Errorf("compile: unsupported type %v: %v", v.Type(), v)
+	}
+	return nil
+}
+
+// quote emits (quote v).
+func (c *compiler) quote(v object.Value) error {
+	c.quoted++
+	defer func() { c.quoted-- }()
+	return c.value(object.Cell(object.Symbol("quote"), object.Cell(v, object.Nil)))
+}
+
+// checkQuoted rejects unquotes. The WAT runtime returns quoted forms
+// verbatim so there is nothing to evaluate them.
+func checkQuoted(v object.Value) error {
+	switch v.Type() {
+	case object.UNQUOTED:
+		return fmt.Errorf("compile: unquote is not supported by the WAT runtime: %v", v)
+	case object.CELL:
+		if err := checkQuoted(v.First()); err != nil {
+			return err
+		}
+		return checkQuoted(v.Rest())
+	case object.QUOTED:
+		return checkQuoted(v.First())
+	default:
+		return nil
+	}
+}
+
+func (c *compiler) symbol(s object.Symbol) {
+	c.line("(call $make_symbol")
+	c.in()
+	c.chain(string(s))
+	c.close()
+	c.out()
+}
+
+// chain emits a binary chain of BYTES1-4 cells holding s, packed little
+// endian the same way as wat/reader.js.
+func (c *compiler) chain(s string) {
+	var closing int
+	for i := 0; i < len(s); i += 4 {
+		end := i + 4
+		if end > len(s) {
+			end = len(s)
+		}
+		var packed uint32
+		for j := end - 1; j >= i; j-- {
+			packed = packed<<8 | uint32(s[j])
+		}
+		c.line("(call $cons (call $make_bytes%v (i32.const 0x%X)) ;; %q", end-i, packed, s[i:end])
+		closing++
+	}
+	c.line("(call $nil)%v", strings.Repeat(")", closing))
+}
+
+func (c *compiler) line(format string, args ...interface{}) {
+	if format != "" {
+		c.b.WriteString(strings.Repeat("  ", c.indent))
+		fmt.Fprintf(&c.b, format, args...)
+	}
+	c.b.WriteString("\n")
+}
+
+// close appends a closing paren to the last line.
+func (c *compiler) close() {
+	s := strings.TrimSuffix(c.b.String(), "\n")
+	c.b.Reset()
+	c.b.WriteString(s)
+	c.b.WriteString(")\n")
+}
+
+func (c *compiler) in() {
+	c.indent += 1
+}
+
+func (c *compiler) out() {
+	c.indent -= 1
+}
@@ -0,0 +1,136 @@
+package wat
+
+import (
+	"dabble/lexer"
+	"dabble/parser"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestCompile(t *testing.T) {
+	runtime, err := ioutil.ReadFile("../../wat/core.wat")
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	tests := []struct {
+		input    string
+		contains []string
+		wantErr  bool
+
+		// quotes is the number of quote symbols, when not zero.
+		quotes int
+	}{{
+		input:    "()",
+		contains: []string{"(call $eval\n      (call $nil)"},
+	}, {
+		input:    "42",
+		contains: []string{"(call $make_number (i32.const 42))"},
+	}, {
+		input:    "-7",
+		contains: []string{"(call $make_number (i32.const -7))"},
+	}, {
+		input:    "(car '(-2147483648))",
+		contains: []string{"(call $make_number (i32.const -2147483648))"},
+		quotes:   1,
+	}, {
+		input: "hello",
+		contains: []string{
+			`(call $cons (call $make_bytes4 (i32.const 0x6C6C6568)) ;; "hell"`,
+			`(call $cons (call $make_bytes1 (i32.const 0x6F)) ;; "o"`,
+		},
+	}, {
+		input: "#u8(72 101 108 108 111)",
+		contains: []string{
+			`(i32.const 0x746F7571)) ;; "quot"`,
+			`(call $cons (call $make_bytes4 (i32.const 0x6C6C6548)) ;; "Hell"`,
+			`(call $cons (call $make_bytes1 (i32.const 0x6F)) ;; "o"`,
+		},
+		quotes: 1,
+	}, {
+		input:    "(car #u8(1 2))",
+		contains: []string{`(call $cons (call $make_bytes2 (i32.const 0x201)) ;; "\x01\x02"`},
+		quotes:   1,
+	}, {
+		input:    "'(#u8(1))",
+		contains: []string{`(call $cons (call $make_bytes1 (i32.const 0x1)) ;; "\x01"`},
+		quotes:   1,
+	}, {
+		input:   "#u8()",
+		wantErr: true,
+	}, {
+		input:    "(car '(1 2))",
+		contains: []string{`(i32.const 0x746F7571)) ;; "quot"`},
+	}, {
+		input:    "(eq 1 1)",
+		contains: []string{`(call $make_builtin (i32.const 4))`},
+	}, {
+		input:   "4294967296",
+		wantErr: true,
+	}, {
+		input:   "-2147483649",
+		wantErr: true,
+	}, {
+		input:   "'(1 `a)",
+		wantErr: true,
+	}}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			l := lexer.New(tt.input)
+			p := parser.New(l)
+			value, err := p.ParseProgram()
+			if err != nil {
+				t.Fatalf(err.Error())
+			}
+			got, err := Compile(value)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("given %v. want err. got %v", value, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("given %v. got err %v", value, err)
+			}
+			for _, c := range tt.contains {
+				if !strings.Contains(got, c) {
+					t.Errorf("given %v. want module containing %q. got\n%v", value, c, got)
+				}
+			}
+			if n := strings.Count(got, `;; "quot"`); tt.quotes != 0 && n != tt.quotes {
+				t.Errorf("given %v. want %v quotes. got %v\n%v", value, tt.quotes, n, got)
+			}
+			if err := Validate(got); err != nil {
+				t.Errorf("given %v. got invalid module %v\n%v", value, err, got)
+			}
+			if err := Link(got, string(runtime)); err != nil {
+				t.Errorf("given %v. got link error %v", value, err)
+			}
+		})
+	}
+}
+
+func TestRuntimeMakeNumber(t *testing.T) {
+	// Compiled numbers are passed to $make_number as i32, so negative
+	// literals rely on it sign-extending them.
+	runtime, err := ioutil.ReadFile("../../wat/core.wat")
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	m, err := parseModule(string(runtime))
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	for _, f := range m.children {
+		if f.keyword() == "func" && f.id() == "$make_number" {
+			if body := f.String(); !strings.Contains(body, "(i64.extend_i32_s (local.get $n))") {
+				t.Errorf("want $make_number to sign-extend. got %v", body)
+			}
+			return
+		}
+	}
+	t.Errorf("want $make_number in core.wat")
+}
@@ -0,0 +1,173 @@
+package wat
+
+import (
+	"fmt"
+	"strings"
+)
+
+// node is a WebAssembly text s-expression. A node is either an atom
+// (keyword, identifier, number or string) or a list of child nodes.
+type node struct {
+	atom     string
+	children []*node
+	list     bool
+}
+
+func (n *node) isList() bool {
+	return n.list
+}
+
+// keyword is the first atom of a list, such as "func" in (func ...).
+func (n *node) keyword() string {
+	if !n.list || len(n.children) == 0 || n.children[0].list {
+		return ""
+	}
+	return n.children[0].atom
+}
+
+// id is the $identifier following the keyword, if any.
+func (n *node) id() string {
+	if !n.list || len(n.children) < 2 || n.children[1].list {
+		return ""
+	}
+	if a := n.children[1].atom; strings.HasPrefix(a, "$") {
+		return a
+	}
+	return ""
+}
+
+func (n *node) String() string {
+	if !n.list {
+		return n.atom
+	}
+	parts := make([]string, len(n.children))
+	for i, c := range n.children {
+		parts[i] = c.String()
+	}
+	return "(" + strings.Join(parts, " ") + ")"
+}
+
+// parseModule reads a single (module ...) s-expression.
+func parseModule(input string) (*node, error) {
+	r := &reader{input: input}
+	nodes, err := r.readList(false)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) != 1 || nodes[0].keyword() != "module" {
+		return nil, fmt.Errorf("expected a single (module ...) form")
+	}
+	return nodes[0], nil
+}
+
+type reader struct {
+	input    string
+	position int
+}
+
+func (r *reader) readList(nested bool) ([]*node, error) {
+	nodes := []*node{}
+	for {
+		if err := r.skip(); err != nil {
+			return nil, err
+		}
+		if r.position >= len(r.input) {
+			if nested {
+				return nil, fmt.Errorf("unexpected end of input")
+			}
+			return nodes, nil
+		}
+		switch ch := r.input[r.position]; ch {
+		case '(':
+			r.position++
+			children, err := r.readList(true)
+			if err != nil {
+				return nil, err
+			}
+			nodes = append(nodes, &node{children: children, list: true})
+		case ')':
+			if !nested {
+				return nil, fmt.Errorf("unexpected ) at offset %v", r.position)
+			}
+			r.position++
+			return nodes, nil
+		case '"':
+			s, err := r.readString()
+			if err != nil {
+				return nil, err
+			}
+			nodes = append(nodes, &node{atom: s})
+		default:
+			start := r.position
+			for r.position < len(r.input) && !isDelimiter(r.input[r.position]) {
+				r.position++
+			}
+			nodes = append(nodes, &node{atom: r.input[start:r.position]})
+		}
+	}
+}
+
+func (r *reader) readString() (string, error) {
+	start := r.position
+	r.position++
+	for r.position < len(r.input) {
+		switch r.input[r.position] {
+		case '\\':
+			r.position += 2
+		case '"':
+			r.position++
+			return r.input[start:r.position], nil
+		default:
+			r.position++
+		}
+	}
+	return "", fmt.Errorf("unterminated string at offset %v", start)
+}
+
+// skip advances past whitespace, line comments and (nested) block comments.
+func (r *reader) skip() error {
+	for r.position < len(r.input) {
+		switch {
+		case isSpace(r.input[r.position]):
+			r.position++
+		case strings.HasPrefix(r.input[r.position:], ";;"):
+			end := strings.IndexByte(r.input[r.position:], '\n')
+			if end < 0 {
+				r.position = len(r.input)
+			} else {
+				r.position += end + 1
+			}
+		case strings.HasPrefix(r.input[r.position:], "(;"):
+			start := r.position
+			depth := 0
+			for {
+				if r.position >= len(r.input) {
+					return fmt.Errorf("unterminated block comment at offset %v", start)
+				}
+				if strings.HasPrefix(r.input[r.position:], "(;") {
+					depth++
+					r.position += 2
+				} else if strings.HasPrefix(r.input[r.position:], ";)") {
+					depth--
+					r.position += 2
+					if depth == 0 {
+						break
+					}
+				} else {
+					r.position++
+				}
+			}
+		default:
+			return nil
+		}
+	}
+	return nil
+}
+
+func isDelimiter(ch byte) bool {
+	return isSpace(ch) || ch == '(' || ch == ')' || ch == '"' || ch == ';'
+}
+
+func isSpace(ch byte) bool {
+	return ch == ' ' || ch == '\t' || ch == '\n' || ch == '\r'
+}
@@ -0,0 +1,283 @@
+package wat
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Validate checks that a WebAssembly text module is well-formed. It parses
+// the s-expression structure and checks that the module fields are known,
+// that identifiers are unique and resolve, and that every call in folded form
+// passes as many operands as the callee has parameters.
+func Validate(module string) error {
+	m, err := parseModule(module)
+	if err != nil {
+		return err
+	}
+	v := &validator{}
+	v.module(m)
+	return v.err()
+}
+
+// Link checks that every import a module makes from RuntimeModule is
+// exported by runtime with the same signature.
+func Link(module, runtime string) error {
+	m, err := parseModule(module)
+	if err != nil {
+		return err
+	}
+	r, err := parseModule(runtime)
+	if err != nil {
+		return err
+	}
+	exports := map[string]signature{}
+	for _, f := range r.children[1:] {
+		if f.keyword() != "func" {
+			continue
+		}
+		for _, c := range f.children[1:] {
+			if c.keyword() == "export" && len(c.children) == 2 {
+				exports[unquote(c.children[1].atom)] = funcSignature(f)
+			}
+		}
+	}
+	v := &validator{}
+	for _, f := range m.children[1:] {
+		if f.keyword() != "import" || len(f.children) != 4 {
+			continue
+		}
+		if unquote(f.children[1].atom) != RuntimeModule {
+			continue
+		}
+		name := unquote(f.children[2].atom)
+		want, ok := exports[name]
+		if !ok {
+			v.error("import %q not exported by runtime", name)
+			continue
+		}
+		if got := funcSignature(f.children[3]); got != want {
+			v.error("import %q has signature %v. runtime has %v", name, got, want)
+		}
+	}
+	return v.err()
+}
+
+type validator struct {
+	errors []string
+
+	funcs   map[string]signature
+	globals map[string]bool
+}
+
+type signature struct {
+	params  int
+	results int
+}
+
+func (s signature) String() string {
+	return fmt.Sprintf("(%v params, %v results)", s.params, s.results)
+}
+
+var moduleFields = map[string]bool{
+	"type":   true,
+	"import": true,
+	"func":   true,
+	"table":  true,
+	"memory": true,
+	"global": true,
+	"export": true,
+	"start":  true,
+	"elem":   true,
+	"data":   true,
+}
+
+func (v *validator) module(m *node) {
+	v.funcs = map[string]signature{}
+	v.globals = map[string]bool{}
+	exports := map[string]bool{}
+	export := func(n *node) {
+		if len(n.children) < 2 || !isString(n.children[1].atom) {
+			v.error("export without name")
+			return
+		}
+		name := n.children[1].atom
+		if exports[name] {
+			v.error("duplicate export %v", name)
+		}
+		exports[name] = true
+	}
+
+	// First pass declares everything so that fields can refer forwards.
+	for _, f := range m.children[1:] {
+		switch k := f.keyword(); {
+		case !moduleFields[k]:
+			v.error("unknown module field %v", f)
+		case k == "import":
+			if len(f.children) != 4 || !isString(f.children[1].atom) || !isString(f.children[2].atom) {
+				v.error("malformed import %v", f)
+				continue
+			}
+			if f.children[3].keyword() == "func" {
+				v.declareFunc(f.children[3])
+			}
+		case k == "func":
+			v.declareFunc(f)
+			for _, c := range f.children[1:] {
+				if c.keyword() == "export" {
+					export(c)
+				}
+			}
+		case k == "global":
+			if name := f.id(); name != "" {
+				if v.globals[name] {
+					v.error("duplicate global %v", name)
+				}
+				v.globals[name] = true
+			}
+		case k == "export":
+			export(f)
+		}
+	}
+
+	for _, f := range m.children[1:] {
+		switch f.keyword() {
+		case "func":
+			v.funcBody(f)
+		case "global":
+			for _, c := range f.children[1:] {
+				v.instr(c, nil, nil)
+			}
+		}
+	}
+}
+
+func (v *validator) declareFunc(f *node) {
+	name := f.id()
+	if name == "" {
+		return
+	}
+	if _, ok := v.funcs[name]; ok {
+		v.error("duplicate func %v", name)
+	}
+	v.funcs[name] = funcSignature(f)
+}
+
+func (v *validator) funcBody(f *node) {
+	locals := map[string]bool{}
+	for _, c := range f.children[1:] {
+		switch c.keyword() {
+		case "param", "local":
+			if name := c.id(); name != "" {
+				if locals[name] {
+					v.error("duplicate local %v in func %v", name, f.id())
+				}
+				locals[name] = true
+			}
+		case "export", "result", "type":
+		default:
+			if c.isList() {
+				v.instr(c, locals, nil)
+			}
+		}
+	}
+}
+
+func (v *validator) instr(n *node, locals map[string]bool, labels []string) {
+	if !n.isList() {
+		return
+	}
+	k := n.keyword()
+	switch k {
+	case "block", "loop", "if":
+		if name := n.id(); name != "" {
+			labels = append(labels, name)
+		}
+	case "call":
+		if len(n.children) < 2 {
+			v.error("call without target")
+			return
+		}
+		name := n.children[1].atom
+		sig, ok := v.funcs[name]
+		if !ok {
+			v.error("call to undefined func %v", name)
+			break
+		}
+		operands := 0
+		for _, c := range n.children[2:] {
+			if c.isList() {
+				operands++
+			}
+		}
+		if operands != sig.params {
+			v.error("call to %v with %v operand(s). want %v", name, operands, sig.params)
+		}
+	case "local.get", "local.set", "local.tee":
+		if len(n.children) < 2 || !locals[n.children[1].atom] {
+			v.error("%v of undefined local %v", k, n)
+		}
+	case "global.get", "global.set":
+		if len(n.children) < 2 || !v.globals[n.children[1].atom] {
+			v.error("%v of undefined global %v", k, n)
+		}
+	case "br", "br_if":
+		if len(n.children) < 2 {
+			v.error("%v without label", k)
+			break
+		}
+		if label := n.children[1].atom; strings.HasPrefix(label, "$") && !contains(labels, label) {
+			v.error("%v to undefined label %v", k, label)
+		}
+	}
+	for _, c := range n.children {
+		v.instr(c, locals, labels)
+	}
+}
+
+func (v *validator) error(s string, args ...interface{}) {
+	v.errors = append(v.errors, fmt.Sprintf(s, args...))
+}
+
+func (v *validator) err() error {
+	if len(v.errors) == 0 {
+		return nil
+	}
+	return fmt.Errorf("invalid module:\n%v", strings.Join(v.errors, "\n"))
+}
+
+func funcSignature(f *node) signature {
+	var s signature
+	for _, c := range f.children[1:] {
+		switch c.keyword() {
+		case "param":
+			if c.id() != "" {
+				s.params += 1
+			} else {
+				s.params += len(c.children) - 1
+			}
+		case "result":
+			s.results += len(c.children) - 1
+		}
+	}
+	return s
+}
+
+func contains(labels []string, label string) bool {
+	for _, l := range labels {
+		if l == label {
+			return true
+		}
+	}
+	return false
+}
+
+func isString(atom string) bool {
+	return len(atom) >= 2 && strings.HasPrefix(atom, "\"") && strings.HasSuffix(atom, "\"")
+}
+
+func unquote(atom string) string {
+	if !isString(atom) {
+		return atom
+	}
+	return atom[1 : len(atom)-1]
+}
@@ -0,0 +1,128 @@
+package wat
+
+import (
+	"io/ioutil"
+	"strconv"
+	"testing"
+)
+
+func TestValidateRuntime(t *testing.T) {
+	runtime, err := ioutil.ReadFile("../../wat/core.wat")
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	if err := Validate(string(runtime)); err != nil {
+		t.Errorf("core.wat: %v", err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		input   string
+		wantErr bool
+	}{{
+		input: "(module)",
+	}, {
+		input: `(module
+  ;; comment
+  (; block (; nested ;) ;)
+  (func $f (param $a i64) (result i64) (local.get $a))
+  (func $g (export "g") (result i64) (call $f (i64.const 1))))`,
+	}, {
+		input: `(module (import "core" "nil" (func $nil (result i64))) (func $g (result i64) (call $nil)))`,
+	}, {
+		input: `(module (func $f (loop $l (br $l))))`,
+	}, {
+		input:   "",
+		wantErr: true,
+	}, {
+		input:   "(module",
+		wantErr: true,
+	}, {
+		input:   "(module))",
+		wantErr: true,
+	}, {
+		input:   "(module) (module)",
+		wantErr: true,
+	}, {
+		input:   "(func)",
+		wantErr: true,
+	}, {
+		input:   "(module (bogus))",
+		wantErr: true,
+	}, {
+		input:   `(module (func $f) (func $f))`,
+		wantErr: true,
+	}, {
+		input:   `(module (func $f (call $g)))`,
+		wantErr: true,
+	}, {
+		input:   `(module (func $f (param i64 i64)) (func $g (call $f (i64.const 1))))`,
+		wantErr: true,
+	}, {
+		input:   `(module (func $f (local.get $x)))`,
+		wantErr: true,
+	}, {
+		input:   `(module (func $f (global.get $x)))`,
+		wantErr: true,
+	}, {
+		input:   `(module (func $f (br $l)))`,
+		wantErr: true,
+	}, {
+		input:   `(module (func (export "f")) (func (export "f")))`,
+		wantErr: true,
+	}, {
+		input:   `(module (import "core" (func $nil)))`,
+		wantErr: true,
+	}, {
+		input:   `(module (; unterminated)`,
+		wantErr: true,
+	}}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			err := Validate(tt.input)
+			if tt.wantErr && err == nil {
+				t.Errorf("given %v. want err", tt.input)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("given %v. got err %v", tt.input, err)
+			}
+		})
+	}
+}
+
+func TestLink(t *testing.T) {
+	runtime := `(module
+  (func $nil (export "nil") (result i64) (i64.const 0))
+  (func $cons (export "cons") (param $a i64) (param $b i64) (result i64) (local.get $a)))`
+
+	tests := []struct {
+		input   string
+		wantErr bool
+	}{{
+		input: `(module (import "core" "nil" (func $nil (result i64))))`,
+	}, {
+		input: `(module (import "core" "cons" (func $cons (param i64 i64) (result i64))))`,
+	}, {
+		input: `(module (import "other" "missing" (func $m)))`,
+	}, {
+		input:   `(module (import "core" "missing" (func $m)))`,
+		wantErr: true,
+	}, {
+		input:   `(module (import "core" "cons" (func $cons (param i64) (result i64))))`,
+		wantErr: true,
+	}}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			err := Link(tt.input, runtime)
+			if tt.wantErr && err == nil {
+				t.Errorf("given %v. want err", tt.input)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("given %v. got err %v", tt.input, err)
+			}
+		})
+	}
+}
@@ -1,3 +1,4 @@
+;; (and x ...) is t if no argument is (), evaluating them in order.
 (macro ((xs))
        (if (eq () xs) t
 	 (label x (car xs)
@@ -1,3 +1,4 @@
+;; (let ((name value) ...) form) evaluates form with each name bound.
 (macro (bindings form)
        (if (eq () bindings) form
 	 (label b (car bindings)
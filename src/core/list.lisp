@@ -1,2 +1,3 @@
+;; list returns its arguments as a list.
 (cons (cons (quote list)
             (cons (macro (form) form) ())) ())
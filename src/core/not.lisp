@@ -1,2 +1,3 @@
+;; (not x) is t if x is () and () otherwise.
 (macro (x (xs))
        '(if (eq () `x) t ()))
@@ -1,3 +1,4 @@
+;; (or x ...) is t if any argument is not ().
 (macro (xs)
        ((lambda (y ys)
           (cond
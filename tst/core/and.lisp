@@ -1,21 +1,11 @@
-(if (eq t (and))
-  (if (eq t (and t))
-    (if (eq t (and t t))
-      (if (eq t (and t t t))
-	(if (eq () (and ()))
-	  (if (eq () (and () ()))
-	    (if (eq () (and () () ()))
-	      (if (eq () (and () t t))
-		(if (eq () (and t () t))
-		  (if (eq () (and t t ()))
-		      t
-		    (error "(and t t ()) must return ()"))
-		  (error "(and t () t()) must return ()"))
-		(error "(and () t t) must return ()"))
-	      (error "(and () () ()) must return ()"))
-	    (error "(and () ()) must return ()"))
-	  (error "(and ()) must return ()"))
-	(error "(and t t t) must return t"))
-      (error "(and t t) must return t"))
-    (error "(and t) must return t"))
-  (error "(and) must return t"))
+;; and is t only when every argument is t.
+(if (eq t (and)) t (error "(and) must return t"))
+(if (eq t (and t)) t (error "(and t) must return t"))
+(if (eq t (and t t)) t (error "(and t t) must return t"))
+(if (eq t (and t t t)) t (error "(and t t t) must return t"))
+(if (eq () (and ())) t (error "(and ()) must return ()"))
+(if (eq () (and () ())) t (error "(and () ()) must return ()"))
+(if (eq () (and () () ())) t (error "(and () () ()) must return ()"))
+(if (eq () (and () t t)) t (error "(and () t t) must return ()"))
+(if (eq () (and t () t)) t (error "(and t () t) must return ()"))
+(if (eq () (and t t ())) t (error "(and t t ()) must return ()"))
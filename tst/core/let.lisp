@@ -1 +1,2 @@
+;; let binds a name for its body.
 (if (eq 1 (let ((x 1)) x)) t ())
@@ -1,7 +1,3 @@
-(if (eq () (not t))
-    (if (eq t (not ()))
-	t
-      (error "(not () must return t"))
-  (error "(not t) must return ()"))
-
-  
+;; not inverts t and ().
+(if (eq () (not t)) t (error "(not t) must return ()"))
+(if (eq t (not ())) t (error "(not ()) must return t"))
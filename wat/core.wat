@@ -49,8 +49,10 @@
   ;; Create a number from i32
   (func $make_number (export "make_number") (param $n i32) (result i64)
     (i64.or
-      ;; Value in low 32 bits (sign-extended from i32)
-      (i64.extend_i32_u (local.get $n))
+      ;; Value in low 56 bits (sign-extended from i32)
+      (i64.and
+        (i64.extend_i32_s (local.get $n))
+        (i64.const 0x00FFFFFFFFFFFFFF))
       ;; Type tag (0x01) in high byte
       (i64.const 0x0100000000000000)))
 
@@ -92,6 +92,7 @@ async function runTests() {
   const numNeg = exports.make_number(-5);
   assertEquals(getType(numNeg), 0x01, 'make_number(-5) has NUMBER type');
   assertEquals(getValue(numNeg), 0xFFFFFFFB, 'make_number(-5) has value -5 as u32');
+  assertEquals(numNeg & 0x00FFFFFFFFFFFFFFn, 0x00FFFFFFFFFFFFFBn, 'make_number(-5) is sign-extended');
 
   // Test get_type
   assertEquals(exports.get_type(0n), 0, 'get_type(nil) returns 0');